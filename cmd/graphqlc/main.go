@@ -6,6 +6,19 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			compiler.Diff(os.Args[2:])
+			return
+		case "fmt":
+			compiler.Format(os.Args[2:])
+			return
+		case "lint":
+			compiler.Lint(os.Args[2:])
+			return
+		}
+	}
 
 	g := compiler.New()
 
@@ -13,5 +26,7 @@ func main() {
 
 	g.BuildTypeMap()
 	g.BuildTypes()
+	g.ValidateTypes()
+	g.ValidateOperations()
 	g.GenerateAllFiles()
 }
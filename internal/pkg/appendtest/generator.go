@@ -16,7 +16,9 @@ func New() *Generator {
 }
 
 func (g *Generator) GenerateAllFiles() {
-	for _, fd := range g.Request.GraphqlFile {
+	r := graphqlc.NewResolver(g.Request)
+	for _, name := range g.Request.FileToGenerate {
+		fd := r.File(name)
 		g.Response.File = append(g.Response.File, &graphqlc.CodeGeneratorResponse_File{
 			Name:    fd.Name + ".test",
 			Content: "// @@graphqlc_insertion_point(COMMENT_TEST)\n",
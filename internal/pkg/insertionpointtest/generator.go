@@ -17,7 +17,9 @@ func New() *Generator {
 }
 
 func (g *Generator) GenerateAllFiles() {
-	for _, fd := range g.Request.GraphqlFile {
+	r := graphqlc.NewResolver(g.Request)
+	for _, name := range g.Request.FileToGenerate {
+		fd := r.File(name)
 		for _, i := range []int{0, 1, 2, 3, 4, 5} {
 			g.Response.File = append(g.Response.File, &graphqlc.CodeGeneratorResponse_File{
 				Name:           fd.Name + ".test",
@@ -0,0 +1,128 @@
+package compiler
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// The built-in scalars and directives of the GraphQL specification are
+// compiled from this file, given to plugins as the first file of every
+// request. Its definitions are marked builtin.
+const builtinFileName = "graphqlc/builtin.graphql"
+
+const builtinSource = `"""
+The ` + "`Int`" + ` scalar type represents non-fractional signed whole numeric values. Int
+can represent values between -(2^31) and 2^31 - 1.
+"""
+scalar Int
+
+"""
+The ` + "`Float`" + ` scalar type represents signed double-precision fractional values as
+specified by [IEEE 754](https://en.wikipedia.org/wiki/IEEE_floating_point).
+"""
+scalar Float
+
+"""
+The ` + "`String`" + ` scalar type represents textual data, represented as UTF-8
+character sequences. The String type is most often used by GraphQL to represent
+free-form human-readable text.
+"""
+scalar String
+
+"""
+The ` + "`Boolean`" + ` scalar type represents ` + "`true`" + ` or ` + "`false`" + `.
+"""
+scalar Boolean
+
+"""
+The ` + "`ID`" + ` scalar type represents a unique identifier, often used to refetch an
+object or as key for a cache. The ID type appears in a JSON response as a
+String; however, it is not intended to be human-readable. When expected as an
+input type, any string (such as ` + "`\"4\"`" + `) or integer (such as ` + "`4`" + `) input value
+will be accepted as an ID.
+"""
+scalar ID
+
+"""
+Directs the executor to skip this field or fragment when the ` + "`if`" + ` argument is
+true.
+"""
+directive @skip(
+  "Skipped when true."
+  if: Boolean!
+) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+"""
+Directs the executor to include this field or fragment only when the ` + "`if`" + `
+argument is true.
+"""
+directive @include(
+  "Included when true."
+  if: Boolean!
+) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+"""
+Marks an element of a GraphQL schema as no longer supported.
+"""
+directive @deprecated(
+  """
+  Explains why this element was deprecated, usually also including a
+  suggestion for how to access supported similar data. Formatted using
+  the Markdown syntax, as specified by [CommonMark](https://commonmark.org/).
+  """
+  reason: String = "No longer supported"
+) on FIELD_DEFINITION | ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION | ENUM_VALUE
+
+"""
+Exposes a URL that specifies the behavior of this scalar.
+"""
+directive @specifiedBy(
+  "The URL that specifies the behavior of this scalar."
+  url: String!
+) on SCALAR
+`
+
+// The directives graphqlc implements itself are compiled from this file,
+// given to plugins after the built-ins file. Its definitions are marked
+// synthesized rather than builtin.
+const directivesFileName = "graphqlc/directives.graphql"
+
+const directivesSource = `"""
+Expands a list field into a Relay connection. The field takes the pagination
+arguments first, after, last and before and its type becomes a connection of
+edges to the items of the list.
+"""
+directive @connection on FIELD_DEFINITION
+`
+
+// markBuiltin marks the definitions of the built-ins file.
+func markBuiltin(fd *FileDescriptor) {
+	for _, desc := range fd.Scalars {
+		desc.Builtin = true
+	}
+	for _, desc := range fd.Directives {
+		desc.Builtin = true
+	}
+}
+
+// markSynthesized marks the definitions of the directives file.
+func markSynthesized(fd *FileDescriptor) {
+	for _, desc := range fd.Directives {
+		desc.Synthesized = true
+	}
+}
+
+// builtinKeys returns the type map keys of the definitions of the built-ins
+// file.
+func builtinKeys(fd *FileDescriptor) map[string]bool {
+	keys := make(map[string]bool)
+	for _, node := range fd.doc.Definitions {
+		switch def := node.(type) {
+		case *ast.ScalarDefinition:
+			keys[def.Name.Value] = true
+		case *parser.DirectiveDefinition:
+			keys[directiveKeyPrefix+def.Name.Value] = true
+		}
+	}
+	return keys
+}
@@ -0,0 +1,163 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// valueError is a constant value which cannot be coerced to its type. path
+// leads from the value coerced to the offending value nested in it.
+type valueError struct {
+	path    []int32
+	message string
+}
+
+// coerceValue coerces a constant value to the input type typ as the
+// specification coerces input values, updating value in place: an Int given
+// for a Float becomes a Float and a value given for a list becomes a list of
+// one. Variables and values of undefined types are accepted, the latter are
+// reported where the type is referenced.
+func (v *validator) coerceValue(value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto) []valueError {
+	return v.coerce(value, typ, nil)
+}
+
+func (v *validator) coerce(value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto, path []int32) []valueError {
+	if value.GetVariableValue() != nil {
+		return nil
+	}
+	if inner, ok := nonNullOf(typ); ok {
+		if value.GetNullValue() != nil {
+			return []valueError{mismatch(path, value, typ)}
+		}
+		return v.coerce(value, inner, path)
+	}
+	if value.GetNullValue() != nil {
+		return nil
+	}
+
+	switch t := typ.GetType().(type) {
+	case *graphqlc.TypeDescriptorProto_ListType:
+		list := value.GetListValue()
+		if list == nil {
+			item := &graphqlc.ValueDescriptorProto{Raw: value.Raw, Value: value.Value}
+			errs := v.coerce(item, t.ListType.Type, path)
+			value.Value = &graphqlc.ValueDescriptorProto_ListValue{
+				ListValue: &graphqlc.ListValueDescriptorProto{Values: []*graphqlc.ValueDescriptorProto{item}},
+			}
+			return errs
+		}
+		var errs []valueError
+		for i, item := range list.Values {
+			errs = append(errs, v.coerce(item, t.ListType.Type, child(path, valueListValueField, listValueValuesField, int32(i)))...)
+		}
+		return errs
+	case *graphqlc.TypeDescriptorProto_NamedType:
+		named := v.types[t.NamedType.Name]
+		if named == nil {
+			return nil
+		}
+		switch named.kind {
+		case scalarKind:
+			return coerceScalar(value, typ, path)
+		case enumKind:
+			return coerceEnum(named, value, typ, path)
+		case inputObjectKind:
+			return v.coerceInputObject(named, value, typ, path)
+		}
+	}
+	return nil
+}
+
+// coerceScalar coerces a value to a built-in scalar. Custom scalars accept
+// any value. An Int beyond 32 bits is kept as the int64 or big value it was
+// parsed as, schemas use Int for wider integers.
+func coerceScalar(value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto, path []int32) []valueError {
+	name := typeString(typ)
+	ok := false
+	switch val := value.Value.(type) {
+	case *graphqlc.ValueDescriptorProto_IntValue, *graphqlc.ValueDescriptorProto_Int64Value, *graphqlc.ValueDescriptorProto_BigValue:
+		if big, isBig := val.(*graphqlc.ValueDescriptorProto_BigValue); isBig && strings.ContainsAny(big.BigValue, ".eE") {
+			// A Float beyond the range of float64
+			if name == "Float" {
+				return []valueError{{path, fmt.Sprintf("Float cannot represent non-finite value %s", value.Raw)}}
+			}
+			break
+		}
+		switch name {
+		case "Int", "ID":
+			ok = true
+		case "Float":
+			value.Value = parser.FloatValue(value.Raw)
+			ok = true
+		}
+	case *graphqlc.ValueDescriptorProto_FloatValue, *graphqlc.ValueDescriptorProto_DoubleValue:
+		ok = name == "Float"
+	case *graphqlc.ValueDescriptorProto_StringValue:
+		ok = name == "String" || name == "ID"
+	case *graphqlc.ValueDescriptorProto_BooleanValue:
+		ok = name == "Boolean"
+	}
+	if !ok && isBuiltinScalar(name) {
+		return []valueError{mismatch(path, value, typ)}
+	}
+	return nil
+}
+
+func isBuiltinScalar(name string) bool {
+	switch name {
+	case "Int", "Float", "String", "Boolean", "ID":
+		return true
+	}
+	return false
+}
+
+func coerceEnum(t *namedType, value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto, path []int32) []valueError {
+	enumValue := value.GetEnumValue()
+	if enumValue == nil {
+		return []valueError{mismatch(path, value, typ)}
+	}
+	for _, ev := range t.values {
+		if ev.Value == enumValue.Value {
+			return nil
+		}
+	}
+	return []valueError{{path, fmt.Sprintf("enum %q has no value %q", t.name, enumValue.Value)}}
+}
+
+func (v *validator) coerceInputObject(t *namedType, value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto, path []int32) []valueError {
+	obj := value.GetObjectValue()
+	if obj == nil {
+		return []valueError{mismatch(path, value, typ)}
+	}
+
+	var errs []valueError
+	seen := make(map[string]bool)
+	for i, f := range obj.Fields {
+		fieldPath := child(path, valueObjectValueField, objectValueFieldsField, int32(i))
+		if seen[f.Name] {
+			errs = append(errs, valueError{fieldPath, fmt.Sprintf("field %s.%s is set more than once", t.name, f.Name)})
+			continue
+		}
+		seen[f.Name] = true
+
+		def := t.inputField(f.Name)
+		if def == nil {
+			errs = append(errs, valueError{fieldPath, fmt.Sprintf("input object %q has no field %q", t.name, f.Name)})
+			continue
+		}
+		errs = append(errs, v.coerce(f.Value, def.Type, child(fieldPath, objectFieldValueField))...)
+	}
+	for _, def := range t.inputFields {
+		if isNonNull(def.Type) && def.DefaultValue == nil && !seen[def.Name] {
+			errs = append(errs, valueError{path, fmt.Sprintf("required field %s.%s is not set", t.name, def.Name)})
+		}
+	}
+	return errs
+}
+
+func mismatch(path []int32, value *graphqlc.ValueDescriptorProto, typ *graphqlc.TypeDescriptorProto) valueError {
+	return valueError{path, fmt.Sprintf("expected %s, found %s", typeString(typ), value.Raw)}
+}
@@ -0,0 +1,97 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+func TestCoerceDefaultValues(t *testing.T) {
+	const types = "enum E { X Y } input I { a: Int! b: [E] }\n"
+	tests := []struct {
+		name     string
+		argument string
+		want     *graphqlc.ValueDescriptorProto // coerced default value
+		wantErr  []string
+	}{
+		{
+			name:     "Int",
+			argument: "limit: Int = 10",
+			want:     &graphqlc.ValueDescriptorProto{Raw: "10", Value: &graphqlc.ValueDescriptorProto_IntValue{IntValue: 10}},
+		},
+		{
+			name:     "Int beyond 32 bits",
+			argument: "limit: Int = 3000000000",
+			want:     &graphqlc.ValueDescriptorProto{Raw: "3000000000", Value: &graphqlc.ValueDescriptorProto_Int64Value{Int64Value: 3000000000}},
+		},
+		{
+			name:     "Int beyond 64 bits",
+			argument: "limit: Int = 100000000000000000000",
+			want:     &graphqlc.ValueDescriptorProto{Raw: "100000000000000000000", Value: &graphqlc.ValueDescriptorProto_BigValue{BigValue: "100000000000000000000"}},
+		},
+		{
+			name:     "Int given for a Float",
+			argument: "f: Float = 1",
+			want:     &graphqlc.ValueDescriptorProto{Raw: "1", Value: &graphqlc.ValueDescriptorProto_FloatValue{FloatValue: 1}},
+		},
+		{
+			name:     "value given for a list",
+			argument: "l: [E] = X",
+			want: &graphqlc.ValueDescriptorProto{Raw: "X", Value: &graphqlc.ValueDescriptorProto_ListValue{
+				ListValue: &graphqlc.ListValueDescriptorProto{Values: []*graphqlc.ValueDescriptorProto{
+					{Raw: "X", Value: &graphqlc.ValueDescriptorProto_EnumValue{EnumValue: &graphqlc.EnumValueDescriptorProto{Value: "X"}}},
+				}},
+			}},
+		},
+		{
+			name:     "null",
+			argument: "s: String = null",
+			want:     &graphqlc.ValueDescriptorProto{Raw: "null", Value: &graphqlc.ValueDescriptorProto_NullValue{NullValue: &graphqlc.NullValueDescriptorProto{}}},
+		},
+		{
+			name:     "null given for a non-null type",
+			argument: "s: String! = null",
+			wantErr:  []string{"query.graphql:2:29: Query.a(s:) has an invalid default value, expected String!, found null"},
+		},
+		{
+			name:     "Float given for an Int",
+			argument: "i: Int = 1.5",
+			wantErr:  []string{"query.graphql:2:25: Query.a(i:) has an invalid default value, expected Int, found 1.5"},
+		},
+		{
+			name:     "unknown enum value",
+			argument: "e: E = Z",
+			wantErr:  []string{`query.graphql:2:23: Query.a(e:) has an invalid default value, enum "E" has no value "Z"`},
+		},
+		{
+			name:     "input object",
+			argument: "i: I = {b: [X, Z], c: 1}",
+			wantErr: []string{
+				`query.graphql:2:23: Query.a(i:) has an invalid default value, required field I.a is not set`,
+				`query.graphql:2:31: Query.a(i:) has an invalid default value, enum "E" has no value "Z"`,
+				`query.graphql:2:35: Query.a(i:) has an invalid default value, input object "I" has no field "c"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, map[string]string{
+				"query.graphql": types + "type Query { a(" + tt.argument + "): Int }",
+			})
+			v := newValidator(g.files, g.schema)
+			v.validate()
+			if got := diagnosticStrings(v.diags); !reflect.DeepEqual(got, tt.wantErr) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.wantErr)
+			}
+			if tt.want == nil {
+				return
+			}
+			query, _ := g.typeMap.object("Query")
+			if got := query.Fields[0].Arguments[0].DefaultValue; !proto.Equal(got, tt.want) {
+				t.Errorf("got default value %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
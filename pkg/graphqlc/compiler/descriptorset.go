@@ -0,0 +1,50 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// writeDescriptorSet writes the files to be generated, as given to plugins,
+// to --descriptor_set_out as a FileDescriptorSet. With --include_imports
+// every file they import, the built-ins file included, is written before
+// the files importing it. SourceCodeInfo is only kept with
+// --include_source_info. The set of a variant is written to a directory
+// named after the variant next to --descriptor_set_out.
+func (g *Generator) writeDescriptorSet(variant string) {
+	files := g.genFiles
+	if g.IncludeImports {
+		files = g.files
+	}
+	set := new(graphqlc.FileDescriptorSet)
+	for _, fd := range g.files {
+		if _, ok := findFile(files, fd.Name); !ok {
+			continue
+		}
+		desc := fd.FileDescriptorGraphql
+		if !g.IncludeSourceInfo {
+			desc = proto.Clone(desc).(*graphqlc.FileDescriptorGraphql)
+			desc.SourceCodeInfo = nil
+		}
+		set.File = append(set.File, desc)
+	}
+
+	data, err := proto.Marshal(set)
+	if err != nil {
+		g.Error(err)
+	}
+	path := g.DescriptorSetOut
+	if variant != "" {
+		path = filepath.Join(filepath.Dir(path), variant, filepath.Base(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			g.Error(err)
+		}
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		g.Error(err)
+	}
+}
@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+func TestWriteDescriptorSet(t *testing.T) {
+	files := map[string]string{
+		"a.graphql": "# import \"b.graphql\"\ntype Query { b: B }",
+		"b.graphql": "# import \"c.graphql\"\ntype B { c: C }",
+		"c.graphql": "type C { c: Int }",
+	}
+	tests := []struct {
+		name           string
+		args           []string
+		wantFiles      []string
+		wantSourceInfo bool
+	}{
+		{
+			name:      "files to generate",
+			wantFiles: []string{"a.graphql"},
+		},
+		{
+			name:      "imports",
+			args:      []string{"--include_imports"},
+			wantFiles: []string{builtinFileName, directivesFileName, "c.graphql", "b.graphql", "a.graphql"},
+		},
+		{
+			name:           "source info",
+			args:           []string{"--include_source_info"},
+			wantFiles:      []string{"a.graphql"},
+			wantSourceInfo: true,
+		},
+		{
+			name:           "imports and source info",
+			args:           []string{"--include_imports", "--include_source_info"},
+			wantFiles:      []string{builtinFileName, directivesFileName, "c.graphql", "b.graphql", "a.graphql"},
+			wantSourceInfo: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTestFiles(t, files)
+			out := filepath.Join(dir, "schema.pb")
+			g := New()
+			g.CommandLineArguments(append([]string{"-I" + dir, "--descriptor_set_out=" + out, filepath.Join(dir, "a.graphql")}, tt.args...))
+			g.BuildTypeMap()
+			g.BuildTypes()
+			g.buildRequest()
+			g.writeDescriptorSet("")
+
+			data, err := ioutil.ReadFile(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			set := new(graphqlc.FileDescriptorSet)
+			if err := proto.Unmarshal(data, set); err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, desc := range set.File {
+				names = append(names, desc.Name)
+				if got := desc.SourceCodeInfo != nil; got != tt.wantSourceInfo {
+					t.Errorf("%s: got SourceCodeInfo %t, want %t", desc.Name, got, tt.wantSourceInfo)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.wantFiles) {
+				t.Errorf("got files %v, want %v", names, tt.wantFiles)
+			}
+			// The files given to plugins keep their SourceCodeInfo
+			if fd, _ := findFile(g.files, "a.graphql"); fd.SourceCodeInfo == nil {
+				t.Errorf("a.graphql: SourceCodeInfo was stripped from the file compiled")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package compiler
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+)
+
+// Error formats, as accepted by --error_format.
+const (
+	ErrorFormatGCC  = "gcc"
+	ErrorFormatMSVS = "msvs"
+)
+
+// Diagnostic is a problem found in a file. Line and Column are 1-based,
+// zero when the position is unknown. A note gives more detail on the
+// diagnostic before it, such as a related definition, or reports something
+// which is not an error, such as a type removed by --prune.
+type Diagnostic struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+	Note    bool
+}
+
+// newDiagnostic returns a diagnostic in fd at loc. Diagnostics of
+// introspection results have no position, loc is in the SDL printed from
+// them.
+func newDiagnostic(fd *FileDescriptor, loc *ast.Location, format string, args ...interface{}) *Diagnostic {
+	d := &Diagnostic{
+		File:    fd.Name,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if !fd.introspected {
+		d.setPosition(loc)
+	}
+	return d
+}
+
+// newSourceDiagnostic returns a diagnostic in the file loc is in.
+func newSourceDiagnostic(loc *ast.Location, format string, args ...interface{}) *Diagnostic {
+	d := &Diagnostic{Message: fmt.Sprintf(format, args...)}
+	if loc != nil && loc.Source != nil {
+		d.File = loc.Source.Name
+	}
+	d.setPosition(loc)
+	return d
+}
+
+func (d *Diagnostic) setPosition(loc *ast.Location) {
+	if loc != nil && loc.Source != nil {
+		l := location.GetLocation(loc.Source, loc.Start)
+		d.Line, d.Column = l.Line, l.Column
+	}
+}
+
+func (d *Diagnostic) Error() string {
+	return d.Format(ErrorFormatGCC)
+}
+
+// Format formats the diagnostic as gcc or Microsoft Visual Studio do.
+func (d *Diagnostic) Format(errorFormat string) string {
+	severity := "error"
+	message := d.Message
+	if d.Note {
+		severity = "note"
+		message = "note: " + message
+	}
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.File, message)
+	}
+	if errorFormat == ErrorFormatMSVS {
+		return fmt.Sprintf("%s(%d) : %s in column=%d: %s", d.File, d.Line, severity, d.Column, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.File, d.Line, d.Column, message)
+}
+
+// reportDiagnostics writes diagnostics to stderr, exiting the program if
+// there are any errors.
+func (g *Generator) reportDiagnostics(diags []*Diagnostic) {
+	errors := 0
+	for _, d := range diags {
+		fmt.Fprintln(os.Stderr, d.Format(g.ErrorFormat))
+		if !d.Note {
+			errors++
+		}
+	}
+	if errors > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,630 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// Severities of the changes between two schemas.
+const (
+	ChangeBreaking  = "breaking"
+	ChangeDangerous = "dangerous"
+	ChangeSafe      = "safe"
+)
+
+// Change is a difference between two schemas. A breaking change may break
+// existing clients, such as a field removed, a dangerous change may change
+// how they behave, such as a default value changed, and a safe change does
+// neither. Coordinate names the definition changed, e.g. User.email,
+// Query.users(first:), Role.ADMIN or @auth(role:). Line and Column are
+// 1-based, zero when the position is unknown. Removals are located in the
+// old schema, every other change in the new one.
+type Change struct {
+	Severity   string `json:"severity"`
+	Coordinate string `json:"coordinate"`
+	Message    string `json:"message"`
+	File       string `json:"file,omitempty"`
+	Line       int    `json:"line,omitempty"`
+	Column     int    `json:"column,omitempty"`
+}
+
+func (c *Change) String() string {
+	switch {
+	case c.File == "":
+		return fmt.Sprintf("%s: %s", c.Severity, c.Message)
+	case c.Line == 0:
+		return fmt.Sprintf("%s: %s: %s", c.File, c.Severity, c.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %s", c.File, c.Line, c.Column, c.Severity, c.Message)
+}
+
+// schemaSource is a schema compared by Diff, the files matching a glob or a
+// FileDescriptorSet written by an earlier compilation with --descriptor_set_out.
+type schemaSource struct {
+	pattern       string
+	descriptorSet string
+}
+
+// Diff runs graphqlc diff, reporting every change from the old schema to the
+// new one and exiting the program with status 1 if any change is breaking.
+//
+//	graphqlc diff [-I PATH]... [--format=text|json] OLD NEW
+//
+// OLD and NEW are each either a glob of the files to compile, quoted so the
+// shell does not expand it, or --descriptor_set_in=FILE. A set written
+// without --include_imports holds none of the types imported, and changes
+// have no positions without --include_source_info. The built-in scalars and
+// directives and the directives graphqlc implements are not compared.
+func Diff(arguments []string) {
+	g := New()
+	var includePaths []string
+	var sources []*schemaSource
+	format := "text"
+	for i := 0; i < len(arguments); i++ {
+		arg := arguments[i]
+		switch {
+		case arg == "-I":
+			if i++; i == len(arguments) {
+				g.Error(fmt.Errorf("missing value for -I"))
+			}
+			includePaths = append(includePaths, arguments[i])
+		case strings.HasPrefix(arg, "-I"):
+			includePaths = append(includePaths, arg[2:])
+		case strings.HasPrefix(arg, "--format="):
+			format = strings.TrimPrefix(arg, "--format=")
+			if format != "text" && format != "json" {
+				g.Error(fmt.Errorf("unknown diff format: %s", format))
+			}
+		case strings.HasPrefix(arg, "--descriptor_set_in="):
+			sources = append(sources, &schemaSource{descriptorSet: strings.TrimPrefix(arg, "--descriptor_set_in=")})
+		case strings.HasPrefix(arg, "-"):
+			g.Error(fmt.Errorf("unknown diff option: %s", arg))
+		default:
+			sources = append(sources, &schemaSource{pattern: arg})
+		}
+	}
+	if len(sources) != 2 {
+		g.Error(fmt.Errorf("diff expects an old and a new schema, %d given", len(sources)))
+	}
+
+	d := &differ{old: sources[0].load(includePaths), new: sources[1].load(includePaths)}
+	d.diff()
+
+	breaking := false
+	for _, c := range d.changes {
+		breaking = breaking || c.Severity == ChangeBreaking
+	}
+	if format == "json" {
+		changes := d.changes
+		if changes == nil {
+			changes = []*Change{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(struct {
+			Changes []*Change `json:"changes"`
+		}{changes}); err != nil {
+			g.Error(err)
+		}
+	} else {
+		for _, c := range d.changes {
+			fmt.Println(c)
+		}
+	}
+	if breaking {
+		os.Exit(1)
+	}
+}
+
+// load compiles the schema, exiting the program if it is invalid.
+func (s *schemaSource) load(includePaths []string) *validator {
+	g := New()
+	if s.descriptorSet != "" {
+		g.loadDescriptorSet(s.descriptorSet)
+		return newValidator(g.files, nil)
+	}
+
+	var args []string
+	for _, path := range includePaths {
+		args = append(args, "-I"+path)
+	}
+	g.CommandLineArguments(append(args, s.pattern))
+	if len(g.genFiles) == 0 {
+		g.Error(fmt.Errorf("%s: no files found", s.pattern))
+	}
+	g.BuildTypeMap()
+	g.BuildTypes()
+	g.ValidateTypes()
+	return newValidator(g.files, g.schema)
+}
+
+// loadDescriptorSet loads the files of a FileDescriptorSet. They were
+// validated when they were compiled and are not validated again.
+func (g *Generator) loadDescriptorSet(path string) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		g.Error(err)
+	}
+	set := new(graphqlc.FileDescriptorSet)
+	if err := proto.Unmarshal(data, set); err != nil {
+		g.Error(fmt.Errorf("%s: %s", path, err))
+	}
+	for _, desc := range set.File {
+		g.files = append(g.files, &FileDescriptor{FileDescriptorGraphql: desc})
+	}
+}
+
+// differ finds the changes between two schemas, each the type system of a
+// validator.
+type differ struct {
+	old, new *validator
+	changes  []*Change
+}
+
+func (d *differ) breaking(s site, coordinate, format string, args ...interface{}) {
+	d.report(ChangeBreaking, s, coordinate, format, args...)
+}
+
+func (d *differ) dangerous(s site, coordinate, format string, args ...interface{}) {
+	d.report(ChangeDangerous, s, coordinate, format, args...)
+}
+
+func (d *differ) safe(s site, coordinate, format string, args ...interface{}) {
+	d.report(ChangeSafe, s, coordinate, format, args...)
+}
+
+func (d *differ) report(severity string, s site, coordinate, format string, args ...interface{}) {
+	c := &Change{Severity: severity, Coordinate: coordinate, Message: fmt.Sprintf(format, args...)}
+	if s.fd != nil {
+		c.File = s.fd.Name
+		c.Line, c.Column = sourcePosition(s.fd, s.path)
+	}
+	d.changes = append(d.changes, c)
+}
+
+// diff finds every change, sorted by coordinate so the changes of a type are
+// reported together.
+func (d *differ) diff() {
+	d.diffRoots()
+	for _, t := range d.old.typeOrder {
+		if isBuiltinSite(t.site) {
+			continue
+		}
+		nt := userType(d.new, t.name)
+		switch {
+		case nt == nil:
+			d.breaking(t.site, t.name, "%s %s was removed", t.kind, t.name)
+		case nt.kind != t.kind:
+			d.breaking(nt.site, t.name, "%s changed from %s to %s", t.name, t.kind.article(), nt.kind.article())
+		default:
+			d.diffType(t, nt)
+		}
+	}
+	for _, t := range d.new.typeOrder {
+		if !isBuiltinSite(t.site) && userType(d.old, t.name) == nil {
+			d.safe(t.site, t.name, "%s %s was added", t.kind, t.name)
+		}
+	}
+
+	for _, def := range d.old.dirOrder {
+		if isBuiltinSite(def.site) {
+			continue
+		}
+		coordinate := "@" + def.Name
+		newDef := userDirective(d.new, def.Name)
+		if newDef == nil {
+			d.breaking(def.site, coordinate, "directive %s was removed", coordinate)
+			continue
+		}
+		d.diffDirective(def, newDef)
+	}
+	for _, def := range d.new.dirOrder {
+		if !isBuiltinSite(def.site) && userDirective(d.old, def.Name) == nil {
+			d.safe(def.site, "@"+def.Name, "directive @%s was added", def.Name)
+		}
+	}
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Coordinate < d.changes[j].Coordinate
+	})
+}
+
+func (d *differ) diffRoots() {
+	oldRoots, newRoots := rootTypes(d.old), rootTypes(d.new)
+	for i, root := range oldRoots {
+		newRoot := newRoots[i]
+		switch {
+		case root == nil && newRoot == nil:
+		case root == nil:
+			d.safe(newRoot.site, "schema", "the %s root operation type %s was added", operationNames[i], newRoot.name)
+		case newRoot == nil:
+			d.breaking(root.site, "schema", "the %s root operation type %s was removed", operationNames[i], root.name)
+		case root.name != newRoot.name:
+			d.breaking(newRoot.site, "schema", "the %s root operation type changed from %s to %s", operationNames[i], root.name, newRoot.name)
+		}
+	}
+}
+
+// rootTypes returns the root operation types of a schema, located at the
+// schema definition or else at the types. A descriptor set without a schema
+// definition has the types named Query, Mutation and Subscription.
+func rootTypes(v *validator) [3]*typeRef {
+	var roots [3]*typeRef
+	if v.roots[0] == nil && v.roots[1] == nil && v.roots[2] == nil {
+		for i, name := range []string{"Query", "Mutation", "Subscription"} {
+			if t := v.types[name]; t != nil && t.kind == objectKind {
+				roots[i] = &typeRef{name: name, site: t.site}
+			}
+		}
+		return roots
+	}
+	for i, root := range v.roots {
+		if root == nil {
+			continue
+		}
+		roots[i] = root
+		if t := v.types[root.name]; t != nil && root.site.fd == nil {
+			roots[i] = &typeRef{name: root.name, site: t.site}
+		}
+	}
+	return roots
+}
+
+func (d *differ) diffType(old, new *namedType) {
+	if old.description != new.description {
+		d.safe(new.site, new.name, "the description of %s changed", new.name)
+	}
+	switch new.kind {
+	case objectKind, interfaceKind:
+		d.diffInterfaces(old, new)
+		d.diffFields(old, new)
+	case unionKind:
+		d.diffMembers(old, new)
+	case enumKind:
+		d.diffEnumValues(old, new)
+	case inputObjectKind:
+		d.diffInputValues("input field", func(name string) string {
+			return new.name + "." + name
+		}, old.inputFields, new.inputFields, ChangeDangerous)
+	}
+}
+
+func (d *differ) diffInterfaces(old, new *namedType) {
+	for _, ref := range old.interfaces {
+		if !new.implements(ref.name) {
+			d.breaking(new.site, new.name, "%s no longer implements %s", new.name, ref.name)
+		}
+	}
+	for _, ref := range new.interfaces {
+		if !old.implements(ref.name) {
+			d.dangerous(ref.site, new.name, "%s now implements %s", new.name, ref.name)
+		}
+	}
+}
+
+// diffFields compares the fields of two objects or interfaces. An output
+// field may become non-null but no other change of its type is safe.
+func (d *differ) diffFields(old, new *namedType) {
+	for _, f := range old.fields {
+		coordinate := new.name + "." + f.Name
+		nf := new.field(f.Name)
+		if nf == nil {
+			d.breaking(f.site, coordinate, "field %s was removed", coordinate)
+			continue
+		}
+		if oldType, newType := typeString(f.Type), typeString(nf.Type); oldType != newType {
+			report := d.breaking
+			if isSafeOutputTypeChange(f.Type, nf.Type) {
+				report = d.safe
+			}
+			report(nf.site, coordinate, "field %s changed type from %s to %s", coordinate, oldType, newType)
+		}
+		d.diffDescription(nf.site, coordinate, "field", f.Description, nf.Description)
+		d.diffDeprecation(nf.site, coordinate, "field", f.Directives, nf.Directives)
+		d.diffInputValues("argument", func(name string) string {
+			return coordinate + "(" + name + ":)"
+		}, f.argumentValues(), nf.argumentValues(), ChangeDangerous)
+	}
+	for _, nf := range new.fields {
+		if old.field(nf.Name) == nil {
+			coordinate := new.name + "." + nf.Name
+			d.safe(nf.site, coordinate, "field %s was added", coordinate)
+		}
+	}
+}
+
+// diffInputValues compares arguments or input fields. A value may become
+// nullable but no other change of its type is safe, as is adding a value
+// which is required. optionalAdded is the severity of adding a value which
+// is not.
+func (d *differ) diffInputValues(kind string, coordinate func(name string) string, old, new []*inputValue, optionalAdded string) {
+	for _, value := range old {
+		c := coordinate(value.Name)
+		nv := findInputValueOf(new, value.Name)
+		if nv == nil {
+			d.breaking(value.site, c, "%s %s was removed", kind, c)
+			continue
+		}
+		if oldType, newType := typeString(value.Type), typeString(nv.Type); oldType != newType {
+			report := d.breaking
+			if isSafeInputTypeChange(value.Type, nv.Type) {
+				report = d.safe
+			}
+			report(nv.site, c, "%s %s changed type from %s to %s", kind, c, oldType, newType)
+		}
+		oldDefault, newDefault := valueString(value.DefaultValue), valueString(nv.DefaultValue)
+		switch {
+		case oldDefault == newDefault:
+		case isRequired(nv.InputValueDefinitionDescriptorProto) && isNonNull(value.Type):
+			d.breaking(nv.site, c, "%s %s no longer has a default value and is required", kind, c)
+		case newDefault == "":
+			d.dangerous(nv.site, c, "the default value %s of %s %s was removed", oldDefault, kind, c)
+		case oldDefault == "":
+			d.dangerous(nv.site, c, "%s %s now defaults to %s", kind, c, newDefault)
+		default:
+			d.dangerous(nv.site, c, "the default value of %s %s changed from %s to %s", kind, c, oldDefault, newDefault)
+		}
+		d.diffDescription(nv.site, c, kind, value.Description, nv.Description)
+		d.diffDeprecation(nv.site, c, kind, value.Directives, nv.Directives)
+	}
+	for _, nv := range new {
+		if findInputValueOf(old, nv.Name) != nil {
+			continue
+		}
+		c := coordinate(nv.Name)
+		if isRequired(nv.InputValueDefinitionDescriptorProto) {
+			d.breaking(nv.site, c, "required %s %s was added", kind, c)
+		} else {
+			d.report(optionalAdded, nv.site, c, "%s %s was added", kind, c)
+		}
+	}
+}
+
+func (d *differ) diffMembers(old, new *namedType) {
+	for _, ref := range old.members {
+		if findTypeRef(new.members, ref.name) == nil {
+			d.breaking(new.site, new.name, "%s was removed from union %s", ref.name, new.name)
+		}
+	}
+	for _, ref := range new.members {
+		if findTypeRef(old.members, ref.name) == nil {
+			d.dangerous(ref.site, new.name, "%s was added to union %s", ref.name, new.name)
+		}
+	}
+}
+
+func (d *differ) diffEnumValues(old, new *namedType) {
+	for _, value := range old.values {
+		coordinate := new.name + "." + value.Value
+		nv := findEnumValue(new.values, value.Value)
+		if nv == nil {
+			d.breaking(value.site, coordinate, "enum value %s was removed", coordinate)
+			continue
+		}
+		d.diffDescription(nv.site, coordinate, "enum value", value.Description, nv.Description)
+		d.diffDeprecation(nv.site, coordinate, "enum value", value.Directives, nv.Directives)
+	}
+	for _, nv := range new.values {
+		if findEnumValue(old.values, nv.Value) == nil {
+			coordinate := new.name + "." + nv.Value
+			d.dangerous(nv.site, coordinate, "enum value %s was added", coordinate)
+		}
+	}
+}
+
+func (d *differ) diffDirective(old, new *directiveDefinition) {
+	coordinate := "@" + new.Name
+	d.diffDescription(new.site, coordinate, "directive", old.Description, new.Description)
+	switch {
+	case old.Repeatable && !new.Repeatable:
+		d.breaking(new.site, coordinate, "directive %s is no longer repeatable", coordinate)
+	case !old.Repeatable && new.Repeatable:
+		d.safe(new.site, coordinate, "directive %s is now repeatable", coordinate)
+	}
+
+	oldLocations, newLocations := directiveLocationNames(old), directiveLocationNames(new)
+	for _, location := range oldLocations {
+		if !containsString(newLocations, location) {
+			d.breaking(new.site, coordinate, "location %s was removed from directive %s", location, coordinate)
+		}
+	}
+	for _, location := range newLocations {
+		if !containsString(oldLocations, location) {
+			d.safe(new.site, coordinate, "location %s was added to directive %s", location, coordinate)
+		}
+	}
+
+	d.diffInputValues("argument", func(name string) string {
+		return coordinate + "(" + name + ":)"
+	}, old.argumentValues(), new.argumentValues(), ChangeSafe)
+}
+
+func (d *differ) diffDescription(s site, coordinate, kind, old, new string) {
+	if old != new {
+		d.safe(s, coordinate, "the description of %s %s changed", kind, coordinate)
+	}
+}
+
+func (d *differ) diffDeprecation(s site, coordinate, kind string, old, new []*graphqlc.DirectiveDescriptorProto) {
+	oldReason, newReason := deprecationReason(old), deprecationReason(new)
+	switch {
+	case oldReason == newReason:
+	case oldReason == "":
+		d.safe(s, coordinate, "%s %s was deprecated", kind, coordinate)
+	case newReason == "":
+		d.safe(s, coordinate, "%s %s is no longer deprecated", kind, coordinate)
+	default:
+		d.safe(s, coordinate, "the deprecation reason of %s %s changed", kind, coordinate)
+	}
+}
+
+// isSafeOutputTypeChange reports whether a field of type old may be changed
+// to type new without breaking clients, only by becoming non-null.
+func isSafeOutputTypeChange(old, new *graphqlc.TypeDescriptorProto) bool {
+	if oldInner, ok := nonNullOf(old); ok {
+		newInner, ok := nonNullOf(new)
+		return ok && isSafeOutputTypeChange(oldInner, newInner)
+	}
+	if newInner, ok := nonNullOf(new); ok {
+		return isSafeOutputTypeChange(old, newInner)
+	}
+	if list := old.GetListType(); list != nil {
+		newList := new.GetListType()
+		return newList != nil && isSafeOutputTypeChange(list.Type, newList.Type)
+	}
+	return new.GetNamedType() != nil && old.GetNamedType().GetName() == new.GetNamedType().GetName()
+}
+
+// isSafeInputTypeChange reports whether an argument or input field of type
+// old may be changed to type new without breaking clients, only by becoming
+// nullable.
+func isSafeInputTypeChange(old, new *graphqlc.TypeDescriptorProto) bool {
+	if newInner, ok := nonNullOf(new); ok {
+		oldInner, ok := nonNullOf(old)
+		return ok && isSafeInputTypeChange(oldInner, newInner)
+	}
+	if oldInner, ok := nonNullOf(old); ok {
+		return isSafeInputTypeChange(oldInner, new)
+	}
+	if list := old.GetListType(); list != nil {
+		newList := new.GetListType()
+		return newList != nil && isSafeInputTypeChange(list.Type, newList.Type)
+	}
+	return new.GetNamedType() != nil && old.GetNamedType().GetName() == new.GetNamedType().GetName()
+}
+
+// isRequired reports whether an argument or input field must be given, it
+// is non-null without a default value.
+func isRequired(desc *graphqlc.InputValueDefinitionDescriptorProto) bool {
+	return isNonNull(desc.Type) && desc.DefaultValue == nil
+}
+
+// valueString formats a value in a canonical form, so values are compared
+// regardless of how they are written. Object fields are sorted by name. A
+// missing value is empty.
+func valueString(value *graphqlc.ValueDescriptorProto) string {
+	switch val := value.GetValue().(type) {
+	case *graphqlc.ValueDescriptorProto_VariableValue:
+		return "$" + val.VariableValue.Name
+	case *graphqlc.ValueDescriptorProto_IntValue:
+		return strconv.FormatInt(int64(val.IntValue), 10)
+	case *graphqlc.ValueDescriptorProto_Int64Value:
+		return strconv.FormatInt(val.Int64Value, 10)
+	case *graphqlc.ValueDescriptorProto_FloatValue:
+		return strconv.FormatFloat(float64(val.FloatValue), 'g', -1, 32)
+	case *graphqlc.ValueDescriptorProto_DoubleValue:
+		return strconv.FormatFloat(val.DoubleValue, 'g', -1, 64)
+	case *graphqlc.ValueDescriptorProto_BigValue:
+		return val.BigValue
+	case *graphqlc.ValueDescriptorProto_BooleanValue:
+		return strconv.FormatBool(val.BooleanValue)
+	case *graphqlc.ValueDescriptorProto_StringValue:
+		return strconv.Quote(val.StringValue)
+	case *graphqlc.ValueDescriptorProto_NullValue:
+		return "null"
+	case *graphqlc.ValueDescriptorProto_EnumValue:
+		return val.EnumValue.Value
+	case *graphqlc.ValueDescriptorProto_ListValue:
+		items := make([]string, len(val.ListValue.Values))
+		for i, item := range val.ListValue.Values {
+			items[i] = valueString(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case *graphqlc.ValueDescriptorProto_ObjectValue:
+		fields := make([]string, len(val.ObjectValue.Fields))
+		for i, f := range val.ObjectValue.Fields {
+			fields[i] = f.Name + ": " + valueString(f.Value)
+		}
+		sort.Strings(fields)
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return value.GetRaw()
+}
+
+// sourcePosition returns the 1-based position of the descriptor at path
+// given by the SourceCodeInfo of a file, zero if it has no location.
+func sourcePosition(fd *FileDescriptor, path []int32) (line, column int) {
+	key := pathKey(path)
+	for _, l := range fd.GetSourceCodeInfo().GetLocation() {
+		if len(l.Span) >= 3 && pathKey(l.Path) == key {
+			return int(l.Span[0]) + 1, int(l.Span[1]) + 1
+		}
+	}
+	return 0, 0
+}
+
+func isBuiltinSite(s site) bool {
+	return s.fd != nil && (s.fd.Name == builtinFileName || s.fd.Name == directivesFileName)
+}
+
+// userType returns the type named name unless it is built in.
+func userType(v *validator, name string) *namedType {
+	if t := v.types[name]; t != nil && !isBuiltinSite(t.site) {
+		return t
+	}
+	return nil
+}
+
+// userDirective returns the directive named name unless it is built in.
+func userDirective(v *validator, name string) *directiveDefinition {
+	if def := v.directives[name]; def != nil && !isBuiltinSite(def.site) {
+		return def
+	}
+	return nil
+}
+
+func (def *directiveDefinition) argumentValues() []*inputValue {
+	return inputValues(def.site.child(directiveDefinitionArgumentsField), def.Arguments)
+}
+
+func directiveLocationNames(def *directiveDefinition) []string {
+	var names []string
+	for _, location := range def.Locations {
+		names = append(names, directiveLocationName(location))
+	}
+	return names
+}
+
+func findInputValueOf(values []*inputValue, name string) *inputValue {
+	for _, value := range values {
+		if value.Name == name {
+			return value
+		}
+	}
+	return nil
+}
+
+func findEnumValue(values []*enumValue, name string) *enumValue {
+	for _, value := range values {
+		if value.Value == name {
+			return value
+		}
+	}
+	return nil
+}
+
+func findTypeRef(refs []*typeRef, name string) *typeRef {
+	for _, ref := range refs {
+		if ref.name == name {
+			return ref
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
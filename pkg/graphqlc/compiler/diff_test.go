@@ -0,0 +1,95 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want []string
+	}{
+		{
+			name: "no change",
+			old:  "type Query { a: Int }",
+			new:  "type Query { a: Int }",
+		},
+		{
+			name: "removed field",
+			old:  "type Query { a: Int b: Int }",
+			new:  "type Query { a: Int }",
+			want: []string{"schema.graphql:1:21: breaking: field Query.b was removed"},
+		},
+		{
+			name: "added field",
+			old:  "type Query { a: Int }",
+			new:  "type Query { a: Int b: Int }",
+			want: []string{"schema.graphql:1:21: safe: field Query.b was added"},
+		},
+		{
+			name: "changed argument type",
+			old:  "type Query { a(x: Int): Int }",
+			new:  "type Query { a(x: String): Int }",
+			want: []string{"schema.graphql:1:16: breaking: argument Query.a(x:) changed type from Int to String"},
+		},
+		{
+			name: "nullable input field becomes non-null",
+			old:  "type Query { a(i: I): Int }\ninput I { x: Int }",
+			new:  "type Query { a(i: I): Int }\ninput I { x: Int! }",
+			want: []string{"schema.graphql:2:11: breaking: input field I.x changed type from Int to Int!"},
+		},
+		{
+			name: "non-null input field becomes nullable",
+			old:  "type Query { a(i: I): Int }\ninput I { x: Int! }",
+			new:  "type Query { a(i: I): Int }\ninput I { x: Int }",
+			want: []string{"schema.graphql:2:11: safe: input field I.x changed type from Int! to Int"},
+		},
+		{
+			name: "nullable field becomes non-null",
+			old:  "type Query { a: Int }",
+			new:  "type Query { a: Int! }",
+			want: []string{"schema.graphql:1:14: safe: field Query.a changed type from Int to Int!"},
+		},
+		{
+			name: "added enum value",
+			old:  "type Query { a: E }\nenum E { X }",
+			new:  "type Query { a: E }\nenum E { X Y }",
+			want: []string{"schema.graphql:2:12: dangerous: enum value E.Y was added"},
+		},
+		{
+			name: "removed union member",
+			old:  "type Query { a: U }\nunion U = A | B\ntype A { a: Int }\ntype B { b: Int }",
+			new:  "type Query { a: U }\nunion U = A\ntype A { a: Int }\ntype B { b: Int }",
+			want: []string{"schema.graphql:2:1: breaking: B was removed from union U"},
+		},
+		{
+			name: "changed default value",
+			old:  "type Query { a(x: Int = 1): Int }",
+			new:  "type Query { a(x: Int = 2): Int }",
+			want: []string{"schema.graphql:1:16: dangerous: the default value of argument Query.a(x:) changed from 1 to 2"},
+		},
+		{
+			name: "equal default values written differently",
+			old:  "type Query { a(i: I = {x: 1, y: 2}): Int }\ninput I { x: Int y: Int }",
+			new:  "type Query { a(i: I = {y: 2, x: 1}): Int }\ninput I { x: Int y: Int }",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := buildTestGenerator(t, map[string]string{"schema.graphql": tt.old})
+			new := buildTestGenerator(t, map[string]string{"schema.graphql": tt.new})
+			d := &differ{old: newValidator(old.files, old.schema), new: newValidator(new.files, new.schema)}
+			d.diff()
+			var got []string
+			for _, c := range d.changes {
+				got = append(got, c.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got changes %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// The meta-field every object, interface and union has.
+const typeNameField = "__typename"
+
+// buildExecutableDescriptors builds the operations and fragments of a file.
+// Selections are resolved against the types of all files, type system
+// extensions included, as seen by the validator.
+func buildExecutableDescriptors(fd *FileDescriptor, tm typeMap, v *validator) error {
+	for _, node := range fd.doc.Definitions {
+		switch def := node.(type) {
+		case *ast.OperationDefinition:
+			desc, err := buildOperationDescriptor(fd, v, def)
+			if err != nil {
+				return err
+			}
+			fd.Operations = append(fd.Operations, desc)
+		case *ast.FragmentDefinition:
+			desc := tm.descriptor(fragmentKeyPrefix + def.Name.Value).(*graphqlc.FragmentDescriptorProto)
+			err := buildFragmentDescriptor(v, desc, def)
+			if err != nil {
+				return err
+			}
+			fd.Fragments = append(fd.Fragments, desc)
+		}
+	}
+	return nil
+}
+
+func buildOperationDescriptor(fd *FileDescriptor, v *validator, node *ast.OperationDefinition) (*graphqlc.OperationDescriptorProto, error) {
+	desc := &graphqlc.OperationDescriptorProto{}
+	var root *typeRef
+	switch node.Operation {
+	case ast.OperationTypeQuery:
+		desc.Operation, root = graphqlc.OperationDescriptorProto_QUERY, v.roots[0]
+	case ast.OperationTypeMutation:
+		desc.Operation, root = graphqlc.OperationDescriptorProto_MUTATION, v.roots[1]
+	case ast.OperationTypeSubscription:
+		desc.Operation, root = graphqlc.OperationDescriptorProto_SUBSCRIPTION, v.roots[2]
+	default:
+		return nil, fmt.Errorf("unknown operation %q", node.Operation)
+	}
+	if node.Name != nil {
+		desc.Name = node.Name.Value
+	}
+
+	for _, variableDef := range node.VariableDefinitions {
+		variableDesc, err := buildVariableDefinitionDescriptor(v, variableDef, fd.variableDirectives[variableDef])
+		if err != nil {
+			return nil, err
+		}
+		if variableDesc.DefaultValue != nil {
+			// Invalid default values are reported by ValidateOperations
+			v.coerceValue(variableDesc.DefaultValue, variableDesc.Type)
+		}
+		desc.VariableDefinitions = append(desc.VariableDefinitions, variableDesc)
+	}
+
+	directiveDescs, err := buildDirectiveUses(v, node.Directives)
+	if err != nil {
+		return nil, err
+	}
+	desc.Directives = directiveDescs
+
+	rootName := ""
+	if root != nil {
+		rootName = root.name
+	}
+	desc.SelectionSet, err = buildSelectionSetDescriptor(v, rootName, node.SelectionSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return desc, nil
+}
+
+func buildVariableDefinitionDescriptor(v *validator, node *ast.VariableDefinition, directives []*ast.Directive) (*graphqlc.VariableDefinitionDescriptorProto, error) {
+	desc := &graphqlc.VariableDefinitionDescriptorProto{
+		Name: node.Variable.Name.Value,
+		Type: parser.TypeDescriptor(node.Type),
+	}
+	if node.DefaultValue != nil {
+		desc.DefaultValue = parser.ValueDescriptor(node.DefaultValue)
+	}
+
+	directiveDescs, err := buildDirectiveUses(v, directives)
+	if err != nil {
+		return nil, err
+	}
+	desc.Directives = directiveDescs
+
+	return desc, nil
+}
+
+func buildFragmentDescriptor(v *validator, desc *graphqlc.FragmentDescriptorProto, node *ast.FragmentDefinition) error {
+	desc.Name = node.Name.Value
+	desc.TypeCondition = &graphqlc.NamedTypeDescriptorProto{Name: node.TypeCondition.Name.Value}
+
+	directiveDescs, err := buildDirectiveUses(v, node.Directives)
+	if err != nil {
+		return err
+	}
+	desc.Directives = directiveDescs
+
+	desc.SelectionSet, err = buildSelectionSetDescriptor(v, desc.TypeCondition.Name, node.SelectionSet)
+	return err
+}
+
+// buildSelectionSetDescriptor builds selections on the type named typeName,
+// an empty name if the type is unknown.
+func buildSelectionSetDescriptor(v *validator, typeName string, node *ast.SelectionSet) (*graphqlc.SelectionSetDescriptorProto, error) {
+	desc := &graphqlc.SelectionSetDescriptorProto{}
+	t := v.types[typeName]
+	if t != nil {
+		desc.Type = &graphqlc.NamedTypeDescriptorProto{Name: typeName}
+	}
+
+	for _, selection := range node.Selections {
+		switch def := selection.(type) {
+		case *ast.Field:
+			fieldDesc, err := buildFieldSelectionDescriptor(v, t, def)
+			if err != nil {
+				return nil, err
+			}
+			desc.Selections = append(desc.Selections, &graphqlc.SelectionDescriptorProto{
+				Selection: &graphqlc.SelectionDescriptorProto_Field{Field: fieldDesc},
+			})
+		case *ast.FragmentSpread:
+			directiveDescs, err := buildDirectiveUses(v, def.Directives)
+			if err != nil {
+				return nil, err
+			}
+			desc.Selections = append(desc.Selections, &graphqlc.SelectionDescriptorProto{
+				Selection: &graphqlc.SelectionDescriptorProto_FragmentSpread{
+					FragmentSpread: &graphqlc.FragmentSpreadDescriptorProto{
+						Name:       def.Name.Value,
+						Directives: directiveDescs,
+					},
+				},
+			})
+		case *ast.InlineFragment:
+			fragmentDesc := &graphqlc.InlineFragmentDescriptorProto{}
+			fragmentType := typeName
+			if def.TypeCondition != nil {
+				fragmentType = def.TypeCondition.Name.Value
+				fragmentDesc.TypeCondition = &graphqlc.NamedTypeDescriptorProto{Name: fragmentType}
+			}
+			directiveDescs, err := buildDirectiveUses(v, def.Directives)
+			if err != nil {
+				return nil, err
+			}
+			fragmentDesc.Directives = directiveDescs
+			fragmentDesc.SelectionSet, err = buildSelectionSetDescriptor(v, fragmentType, def.SelectionSet)
+			if err != nil {
+				return nil, err
+			}
+			desc.Selections = append(desc.Selections, &graphqlc.SelectionDescriptorProto{
+				Selection: &graphqlc.SelectionDescriptorProto_InlineFragment{InlineFragment: fragmentDesc},
+			})
+		default:
+			return nil, fmt.Errorf("unknown selection %T", selection)
+		}
+	}
+
+	return desc, nil
+}
+
+// buildFieldSelectionDescriptor builds a field selected from t, nil if the
+// type is unknown.
+func buildFieldSelectionDescriptor(v *validator, t *namedType, node *ast.Field) (*graphqlc.FieldSelectionDescriptorProto, error) {
+	desc := &graphqlc.FieldSelectionDescriptorProto{
+		Name: node.Name.Value,
+	}
+	if node.Alias != nil {
+		desc.Alias = node.Alias.Value
+	}
+
+	for _, argument := range node.Arguments {
+		desc.Arguments = append(desc.Arguments, parser.ArgumentDescriptor(argument))
+	}
+
+	directiveDescs, err := buildDirectiveUses(v, node.Directives)
+	if err != nil {
+		return nil, err
+	}
+	desc.Directives = directiveDescs
+
+	var def *field
+	if t != nil {
+		if desc.Name == typeNameField && t.kind.isComposite() {
+			desc.ParentType = &graphqlc.NamedTypeDescriptorProto{Name: t.name}
+		} else if def = t.field(desc.Name); def != nil {
+			desc.ParentType = &graphqlc.NamedTypeDescriptorProto{Name: t.name}
+		}
+	}
+
+	if node.SelectionSet != nil {
+		fieldType := ""
+		if def != nil {
+			fieldType = namedTypeName(def.Type)
+		}
+		desc.SelectionSet, err = buildSelectionSetDescriptor(v, fieldType, node.SelectionSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return desc, nil
+}
+
+// buildDirectiveUses builds the directives used in an executable document
+// linked to their definitions, see completeDirective. Their arguments are
+// validated by ValidateOperations.
+func buildDirectiveUses(v *validator, nodes []*ast.Directive) ([]*graphqlc.DirectiveDescriptorProto, error) {
+	descs := parser.DirectiveDescriptors(nodes)
+	for _, desc := range descs {
+		if def, ok := v.directives[desc.Name]; ok {
+			completeDirective(desc, def)
+		}
+	}
+	return descs, nil
+}
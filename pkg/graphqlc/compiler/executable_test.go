@@ -0,0 +1,56 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+func TestFieldSelectionParentType(t *testing.T) {
+	g := buildTestGenerator(t, map[string]string{
+		"query.graphql": `type Query { a: A n: Node u: U }
+			interface Node { id: ID! }
+			type A implements Node { id: ID! }
+			union U = A
+			extend type A { b: Int }
+			{ __typename a { id b } n { id } u { __typename ... on A { b } } }`,
+	})
+	g.buildRequest()
+	r := graphqlc.NewResolver(g.Request)
+	fd := r.File("query.graphql")
+
+	// Every field selected, as parent type, field name and field type
+	var got []string
+	var walk func(set *graphqlc.SelectionSetDescriptorProto)
+	walk = func(set *graphqlc.SelectionSetDescriptorProto) {
+		for _, selection := range set.GetSelections() {
+			switch s := selection.Selection.(type) {
+			case *graphqlc.SelectionDescriptorProto_Field:
+				if file := s.Field.ParentType.GetFile(); file != "query.graphql" {
+					t.Errorf("%s: got parent type defined in %q, want query.graphql", s.Field.Name, file)
+				}
+				def := r.Field(s.Field)
+				got = append(got, s.Field.ParentType.GetName()+"."+s.Field.Name+": "+typeString(def.GetType()))
+				walk(s.Field.SelectionSet)
+			case *graphqlc.SelectionDescriptorProto_InlineFragment:
+				walk(s.InlineFragment.SelectionSet)
+			}
+		}
+	}
+	walk(fd.Operations[0].SelectionSet)
+	want := []string{
+		"Query.__typename: String!",
+		"Query.a: A",
+		"A.id: ID!",
+		"A.b: Int",
+		"Query.n: Node",
+		"Node.id: ID!",
+		"Query.u: U",
+		"U.__typename: String!",
+		"A.b: Int",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got fields %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,233 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// mergeTypeExtensions folds every type system extension in fd into the
+// definition it extends, leaving fd without type extensions. The locations
+// of what an extension adds move to where it is merged when the definition
+// is in the same file, and are dropped otherwise.
+func mergeTypeExtensions(tm typeMap, schema *graphqlc.SchemaDescriptorProto, fd *FileDescriptor) error {
+	rl := make(relocations)
+	for i, ext := range fd.TypeExtensions {
+		extPath := []int32{fileTypeExtensionsField, int32(i)}
+		if schemaExt := ext.GetSchemaExtension(); schemaExt != nil {
+			if def, ok := tm[schemaKey]; ok && def.file == fd {
+				from := child(extPath, typeSystemExtensionSchemaField)
+				to := []int32{fileSchemaField}
+				rl.addElements(child(from, schemaExtensionDirectivesField), child(to, schemaDirectivesField), len(schema.Directives), len(schemaExt.Directives))
+				if schemaExt.Query != nil && schema.Query == nil {
+					rl.add(child(from, schemaExtensionQueryField), child(to, schemaQueryField))
+				}
+				if schemaExt.Mutation != nil && schema.Mutation == nil {
+					rl.add(child(from, schemaExtensionMutationField), child(to, schemaMutationField))
+				}
+				if schemaExt.Subscription != nil && schema.Subscription == nil {
+					rl.add(child(from, schemaExtensionSubscriptionField), child(to, schemaSubscriptionField))
+				}
+			}
+			if err := mergeSchemaExtension(schema, schemaExt); err != nil {
+				return fmt.Errorf("%s: %s", fd.Name, err)
+			}
+			continue
+		}
+
+		typeExt := ext.GetTypeExtension()
+		var name string
+		var ok bool
+		from := child(extPath, typeSystemExtensionTypeField)
+		switch typeExt := typeExt.GetTypeExtension().(type) {
+		case *graphqlc.TypeExtensionDescriptorProto_ScalarTypeExtension:
+			name = typeExt.ScalarTypeExtension.Name
+			var desc *graphqlc.ScalarTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.ScalarTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionScalarField)
+					rl.addElements(child(from, extensionDirectivesField), child(to, scalarDirectivesField), len(desc.Directives), len(typeExt.ScalarTypeExtension.Directives))
+				}
+				desc.Directives = append(desc.Directives, typeExt.ScalarTypeExtension.Directives...)
+				if desc.SpecifiedByUrl == "" {
+					desc.SpecifiedByUrl = parser.SpecifiedByURL(typeExt.ScalarTypeExtension.Directives)
+				}
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+			name = typeExt.ObjectTypeExtension.Name
+			var desc *graphqlc.ObjectTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.ObjectTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionObjectField)
+					rl.addElements(child(from, objectImplementsField), child(to, objectImplementsField), len(desc.Implements), len(typeExt.ObjectTypeExtension.Implements))
+					rl.addElements(child(from, objectDirectivesField), child(to, objectDirectivesField), len(desc.Directives), len(typeExt.ObjectTypeExtension.Directives))
+					rl.addElements(child(from, objectFieldsField), child(to, objectFieldsField), len(desc.Fields), len(typeExt.ObjectTypeExtension.Fields))
+				}
+				desc.Implements = append(desc.Implements, typeExt.ObjectTypeExtension.Implements...)
+				desc.Directives = append(desc.Directives, typeExt.ObjectTypeExtension.Directives...)
+				desc.Fields = append(desc.Fields, typeExt.ObjectTypeExtension.Fields...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+			name = typeExt.InterfaceTypeExtension.Name
+			var desc *graphqlc.InterfaceTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.InterfaceTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionInterfaceField)
+					rl.addElements(child(from, interfaceExtensionImplementsField), child(to, interfaceImplementsField), len(desc.Implements), len(typeExt.InterfaceTypeExtension.Implements))
+					rl.addElements(child(from, extensionDirectivesField), child(to, interfaceDirectivesField), len(desc.Directives), len(typeExt.InterfaceTypeExtension.Directives))
+					rl.addElements(child(from, extensionMembersField), child(to, interfaceFieldsField), len(desc.Fields), len(typeExt.InterfaceTypeExtension.Fields))
+				}
+				desc.Implements = append(desc.Implements, typeExt.InterfaceTypeExtension.Implements...)
+				desc.Directives = append(desc.Directives, typeExt.InterfaceTypeExtension.Directives...)
+				desc.Fields = append(desc.Fields, typeExt.InterfaceTypeExtension.Fields...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension:
+			name = typeExt.UnionTypeExtension.Name
+			var desc *graphqlc.UnionTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.UnionTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionUnionField)
+					rl.addElements(child(from, extensionDirectivesField), child(to, unionDirectivesField), len(desc.Directives), len(typeExt.UnionTypeExtension.Directives))
+					rl.addElements(child(from, extensionMembersField), child(to, unionMemberTypesField), len(desc.MemberTypes), len(typeExt.UnionTypeExtension.MemberTypes))
+				}
+				desc.Directives = append(desc.Directives, typeExt.UnionTypeExtension.Directives...)
+				desc.MemberTypes = append(desc.MemberTypes, typeExt.UnionTypeExtension.MemberTypes...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions:
+			name = typeExt.EnumTypeExtions.Name
+			var desc *graphqlc.EnumTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.EnumTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionEnumField)
+					rl.addElements(child(from, extensionDirectivesField), child(to, enumDirectivesField), len(desc.Directives), len(typeExt.EnumTypeExtions.Directives))
+					rl.addElements(child(from, extensionMembersField), child(to, enumValuesField), len(desc.Values), len(typeExt.EnumTypeExtions.Values))
+				}
+				desc.Directives = append(desc.Directives, typeExt.EnumTypeExtions.Directives...)
+				desc.Values = append(desc.Values, typeExt.EnumTypeExtions.Values...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension:
+			name = typeExt.InputObjectTypeExtension.Name
+			var desc *graphqlc.InputObjectTypeDefinitionDescriptorProto
+			if desc, ok = tm.descriptor(name).(*graphqlc.InputObjectTypeDefinitionDescriptorProto); ok {
+				if to, local := definitionPath(tm[name], fd); local {
+					from := child(from, typeExtensionInputObjectField)
+					rl.addElements(child(from, extensionDirectivesField), child(to, inputObjectDirectivesField), len(desc.Directives), len(typeExt.InputObjectTypeExtension.Directives))
+					rl.addElements(child(from, extensionMembersField), child(to, inputObjectFieldsField), len(desc.Fields), len(typeExt.InputObjectTypeExtension.Fields))
+				}
+				desc.Directives = append(desc.Directives, typeExt.InputObjectTypeExtension.Directives...)
+				desc.Fields = append(desc.Fields, typeExt.InputObjectTypeExtension.Fields...)
+			}
+		}
+		if !ok {
+			if _, defined := tm[name]; defined {
+				return fmt.Errorf("%s: cannot extend %q, extension kind does not match its definition", fd.Name, name)
+			}
+			return fmt.Errorf("%s: cannot extend undefined type %q", fd.Name, name)
+		}
+	}
+	rl.apply(fd)
+	fd.TypeExtensions = nil
+	return nil
+}
+
+func mergeSchemaExtension(desc *graphqlc.SchemaDescriptorProto, ext *graphqlc.SchemaExtensionDescriptorProto) error {
+	desc.Directives = append(desc.Directives, ext.Directives...)
+	if ext.Query != nil {
+		if desc.Query != nil && desc.Query.Name != ext.Query.Name {
+			return fmt.Errorf("schema extension redefines the query type")
+		}
+		desc.Query = ext.Query
+	}
+	if ext.Mutation != nil {
+		if desc.Mutation != nil && desc.Mutation.Name != ext.Mutation.Name {
+			return fmt.Errorf("schema extension redefines the mutation type")
+		}
+		desc.Mutation = ext.Mutation
+	}
+	if ext.Subscription != nil {
+		if desc.Subscription != nil && desc.Subscription.Name != ext.Subscription.Name {
+			return fmt.Errorf("schema extension redefines the subscription type")
+		}
+		desc.Subscription = ext.Subscription
+	}
+	return nil
+}
+
+// definitionPath returns the path of a type definition in its file, if its
+// file is fd.
+func definitionPath(def *definition, fd *FileDescriptor) ([]int32, bool) {
+	if def.file != fd {
+		return nil, false
+	}
+	for i, desc := range fd.Scalars {
+		if desc == def.desc {
+			return []int32{fileScalarsField, int32(i)}, true
+		}
+	}
+	for i, desc := range fd.Objects {
+		if desc == def.desc {
+			return []int32{fileObjectsField, int32(i)}, true
+		}
+	}
+	for i, desc := range fd.Interfaces {
+		if desc == def.desc {
+			return []int32{fileInterfacesField, int32(i)}, true
+		}
+	}
+	for i, desc := range fd.Unions {
+		if desc == def.desc {
+			return []int32{fileUnionsField, int32(i)}, true
+		}
+	}
+	for i, desc := range fd.Enums {
+		if desc == def.desc {
+			return []int32{fileEnumsField, int32(i)}, true
+		}
+	}
+	for i, desc := range fd.InputObjects {
+		if desc == def.desc {
+			return []int32{fileInputObjectsField, int32(i)}, true
+		}
+	}
+	return nil, false
+}
+
+// relocations are the new paths of what type extensions add once merged
+// into the definitions they extend, keyed by their path in the extension.
+type relocations map[string][]int32
+
+// add records that the element at from is moved to to.
+func (rl relocations) add(from, to []int32) {
+	rl[pathKey(from)] = to
+}
+
+// addElements records that the n elements of the repeated field from are
+// appended to the repeated field to, which already has base elements.
+func (rl relocations) addElements(from, to []int32, base, n int) {
+	for i := 0; i < n; i++ {
+		rl.add(child(from, int32(i)), child(to, int32(base+i)))
+	}
+}
+
+// apply updates the SourceCodeInfo of a file once its type extensions are
+// merged. The locations of the elements moved take their new paths and the
+// locations left in the type extensions are dropped.
+func (rl relocations) apply(fd *FileDescriptor) {
+	if fd.SourceCodeInfo == nil {
+		return
+	}
+	for _, l := range fd.SourceCodeInfo.Location {
+		for n := len(l.Path); n > 0; n-- {
+			if to, ok := rl[pathKey(l.Path[:n])]; ok {
+				l.Path = child(to, l.Path[n:]...)
+				break
+			}
+		}
+	}
+	rm := make(removals)
+	for i := range fd.TypeExtensions {
+		rm.add([]int32{fileTypeExtensionsField, int32(i)})
+	}
+	rm.apply(fd)
+}
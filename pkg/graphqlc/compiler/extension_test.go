@@ -0,0 +1,65 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeTypeExtensionsSourceCodeInfo(t *testing.T) {
+	files := map[string]string{
+		"a.graphql": `schema { query: Query }
+type Query { a: Int }
+extend type Query @d {
+  b: String
+}
+extend schema @d { mutation: Mutation }
+type Mutation { m: Int }
+enum E { X }
+extend enum E { Y }
+extend type B { c: Int }
+directive @d on OBJECT | SCHEMA
+`,
+		"b.graphql": "type B { b: Int }\n",
+	}
+	tests := []struct {
+		file string
+		path []int32
+		span []int32 // start line and column, zero based
+	}{
+		{"a.graphql", []int32{fileObjectsField, 0, objectDirectivesField, 0}, []int32{2, 18}},
+		{"a.graphql", []int32{fileObjectsField, 0, objectFieldsField, 1}, []int32{3, 2}},
+		{"a.graphql", []int32{fileObjectsField, 0, objectFieldsField, 1, fieldNameField}, []int32{3, 2}},
+		{"a.graphql", []int32{fileSchemaField, schemaDirectivesField, 0}, []int32{5, 14}},
+		{"a.graphql", []int32{fileSchemaField, schemaMutationField}, []int32{5, 19}},
+		{"a.graphql", []int32{fileEnumsField, 0, enumValuesField, 1}, []int32{8, 16}},
+		// Merged into a definition in another file
+		{"b.graphql", []int32{fileObjectsField, 0, objectFieldsField, 1}, nil},
+	}
+
+	g := buildTestGenerator(t, files)
+	g.MergeExtensions = true
+	g.buildRequest()
+
+	for _, fd := range g.files {
+		for _, l := range fd.GetSourceCodeInfo().GetLocation() {
+			if len(l.Path) > 0 && l.Path[0] == fileTypeExtensionsField {
+				t.Errorf("%s: location of merged type extension %v", fd.Name, l.Path)
+			}
+		}
+	}
+	for _, tt := range tests {
+		fd, ok := findFile(g.files, tt.file)
+		if !ok {
+			t.Fatalf("%s: file not found", tt.file)
+		}
+		var span []int32
+		for _, l := range fd.GetSourceCodeInfo().GetLocation() {
+			if reflect.DeepEqual(l.Path, tt.path) {
+				span = l.Span[:2]
+			}
+		}
+		if !reflect.DeepEqual(span, tt.span) {
+			t.Errorf("%s: location of %v starts at %v, want %v", tt.file, tt.path, span, tt.span)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/format"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// Format runs graphqlc fmt, formatting the files given in the canonical
+// layout of package format. Formatted files are written to stdout, unless
+//
+//	graphqlc fmt [--check|--write] [--sort] [--line_length=N] [--error_format=gcc|msvs] FILES...
+//
+// --check lists the files which are not formatted, exiting the program with
+// status 1 if there are any, and --write rewrites them. Files with syntax
+// errors are left as they are and reported as compile errors are.
+func Format(arguments []string) {
+	g := New()
+	var patterns []string
+	check, write := false, false
+	opts := format.Options{}
+	for _, arg := range arguments {
+		switch {
+		case arg == "--check":
+			check = true
+		case arg == "--write":
+			write = true
+		case arg == "--sort":
+			opts.Sort = true
+		case strings.HasPrefix(arg, "--line_length="):
+			n, err := strconv.Atoi(strings.TrimPrefix(arg, "--line_length="))
+			if err != nil || n <= 0 {
+				g.Error(fmt.Errorf("invalid line length: %s", strings.TrimPrefix(arg, "--line_length=")))
+			}
+			opts.LineLength = n
+		case strings.HasPrefix(arg, "--error_format="):
+			g.ErrorFormat = strings.TrimPrefix(arg, "--error_format=")
+			if g.ErrorFormat != ErrorFormatGCC && g.ErrorFormat != ErrorFormatMSVS {
+				g.Error(fmt.Errorf("unknown error format: %s", g.ErrorFormat))
+			}
+		case strings.HasPrefix(arg, "-"):
+			g.Error(fmt.Errorf("unknown fmt option: %s", arg))
+		default:
+			patterns = append(patterns, arg)
+		}
+	}
+	if check && write {
+		g.Error(fmt.Errorf("--check and --write are mutually exclusive"))
+	}
+
+	var paths []string
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			g.Error(err)
+		}
+		paths = append(paths, files...)
+	}
+	if len(paths) == 0 {
+		g.Error(fmt.Errorf("no input files"))
+	}
+
+	var diags []*Diagnostic
+	unformatted := false
+	for _, path := range paths {
+		if filepath.Ext(path) == introspectionExt {
+			g.Error(fmt.Errorf("%s: introspection results cannot be formatted", path))
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			g.Error(err)
+		}
+		file, errs := parser.Parse(source.NewSource(&source.Source{Body: data, Name: path}))
+		if len(errs) > 0 {
+			for _, err := range errs {
+				diags = append(diags, newSourceDiagnostic(err.Loc, "%s", err.Message))
+			}
+			continue
+		}
+		out, err := format.File(file, opts)
+		if err != nil {
+			g.Error(err)
+		}
+
+		switch {
+		case check:
+			if !bytes.Equal(out, data) {
+				fmt.Println(path)
+				unformatted = true
+			}
+		case write:
+			if bytes.Equal(out, data) {
+				continue
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				g.Error(err)
+			}
+			if err := ioutil.WriteFile(path, out, info.Mode()); err != nil {
+				g.Error(err)
+			}
+		default:
+			os.Stdout.Write(out)
+		}
+	}
+	g.reportDiagnostics(diags)
+	if unformatted {
+		os.Exit(1)
+	}
+}
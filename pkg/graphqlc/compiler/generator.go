@@ -5,17 +5,16 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/graphql-go/graphql/language/ast"
-	"github.com/graphql-go/graphql/language/parser"
 	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
 )
 
 // because Google did it with protobuf/
@@ -30,9 +29,15 @@ const GRAPHQLC_VERSION_SUFFIX = ""
 
 type FileDescriptor struct {
 	*graphqlc.FileDescriptorGraphql
-	path    string
-	doc     *ast.Document
-	typeMap map[string]interface{}
+	path               string
+	url                string // Endpoint the file is introspected from, if any
+	introspected       bool   // Parsed from the SDL printed from an introspection result
+	body               []byte
+	doc                *ast.Document
+	tokens             []parser.Token
+	comments           []parser.Comment
+	variableDirectives map[*ast.VariableDefinition][]*ast.Directive // Directives of variable definitions
+	locations          sourceLocations                              // Where each descriptor is defined, by path
 }
 
 type PluginMeta struct {
@@ -43,9 +48,31 @@ type Generator struct {
 	*graphqlc.Generator
 
 	PluginParams map[string]*PluginMeta // Map from plugin suffix to parameters
+	Transformers []*TransformerMeta     // Transformer plugins, in command line order
+
+	MergeExtensions bool     // Fold type system extensions into the types they extend
+	Prune           bool     // Remove the types which are not reachable
+	PruneKeep       []string // Types kept by Prune even if they are not reachable
+	Variants        []string // Schema variants generated, each a request of its own
+	VariantsConfig  string   // File defining the schema variants
+	IncludePaths    []string // Directories searched for imports and files to be generated
+	ErrorFormat     string   // Format of diagnostics, gcc or msvs
+
+	DescriptorSetOut  string // File the compiled FileDescriptorSet is written to
+	IncludeSourceInfo bool   // Keep the SourceCodeInfo of the files of the FileDescriptorSet
+	IncludeImports    bool   // Add the files imported to the FileDescriptorSet
+
+	IntrospectHeader http.Header // Headers sent with introspection queries
 
 	genFiles []*FileDescriptor // Files to be generated
+	files    []*FileDescriptor // Files to be generated and their imports, imports first
 	file     *FileDescriptor   // File we are compiling now
+	builtins map[string]bool   // Keys of the definitions of the built-ins and directives files
+
+	syntaxErrors []*Diagnostic // Syntax errors of all files
+
+	typeMap typeMap                         // Definitions of all files
+	schema  *graphqlc.SchemaDescriptorProto // Schema of all files
 }
 
 func New() *Generator {
@@ -58,138 +85,190 @@ func New() *Generator {
 func (g *Generator) CommandLineArguments(arguments []string) {
 	g.PluginParams = make(map[string]*PluginMeta)
 	g.genFiles = make([]*FileDescriptor, 0)
-
-	for _, arg := range arguments {
-		if arg[:2] == "--" {
+	g.IntrospectHeader = make(http.Header)
+
+	for i := 0; i < len(arguments); i++ {
+		arg := arguments[i]
+		switch {
+		case arg == "--merge_extensions":
+			g.MergeExtensions = true
+		case arg == "--prune":
+			g.Prune = true
+		case strings.HasPrefix(arg, "--variant="):
+			g.Variants = append(g.Variants, strings.TrimPrefix(arg, "--variant="))
+		case strings.HasPrefix(arg, "--variants_config="):
+			g.VariantsConfig = strings.TrimPrefix(arg, "--variants_config=")
+		case strings.HasPrefix(arg, "--descriptor_set_out="):
+			g.DescriptorSetOut = strings.TrimPrefix(arg, "--descriptor_set_out=")
+		case arg == "--include_source_info":
+			g.IncludeSourceInfo = true
+		case arg == "--include_imports":
+			g.IncludeImports = true
+		case strings.HasPrefix(arg, "--prune_keep="):
+			g.PruneKeep = append(g.PruneKeep, strings.Split(strings.TrimPrefix(arg, "--prune_keep="), ",")...)
+		case arg == "-I":
+			if i++; i == len(arguments) {
+				g.Error(fmt.Errorf("missing value for -I"))
+			}
+			g.IncludePaths = append(g.IncludePaths, arguments[i])
+		case strings.HasPrefix(arg, "-I"):
+			g.IncludePaths = append(g.IncludePaths, arg[2:])
+		case strings.HasPrefix(arg, "--error_format="):
+			g.ErrorFormat = strings.TrimPrefix(arg, "--error_format=")
+			if g.ErrorFormat != ErrorFormatGCC && g.ErrorFormat != ErrorFormatMSVS {
+				g.Error(fmt.Errorf("unknown error format: %s", g.ErrorFormat))
+			}
+		case strings.HasPrefix(arg, "--introspect="):
+			url := strings.TrimPrefix(arg, "--introspect=")
+			name, err := introspectionName(url)
+			if err != nil {
+				g.Error(err)
+			}
+			g.genFiles = append(g.genFiles, &FileDescriptor{
+				FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{Name: name},
+				url:                   url,
+			})
+		case strings.HasPrefix(arg, "--introspect_header="):
+			header := strings.TrimPrefix(arg, "--introspect_header=")
+			i := strings.Index(header, ":")
+			if i < 1 {
+				g.Error(fmt.Errorf("invalid introspection header, expected name: value: %s", header))
+			}
+			g.IntrospectHeader.Add(strings.TrimSpace(header[:i]), strings.TrimSpace(header[i+1:]))
+		case strings.HasPrefix(arg, "--graphql_path="):
+			g.IncludePaths = append(g.IncludePaths, strings.TrimPrefix(arg, "--graphql_path="))
+		case strings.HasPrefix(arg, "--"):
+			if t, ok := parseTransformerArgument(arg[2:]); ok {
+				g.Transformers = append(g.Transformers, t)
+				continue
+			}
 			suffix, params, path := parsePluginArgument(arg[2:])
 			g.PluginParams[suffix] = &PluginMeta{Params: params, Path: path}
-		} else {
+		default:
 			files, err := filepath.Glob(arg)
 			if err != nil {
 				g.Error(err)
 			}
 			for _, file := range files {
 				g.genFiles = append(g.genFiles, &FileDescriptor{
-					FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{
-						Name: file,
-					},
+					FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{},
+					path:                  file,
 				})
 			}
 		}
 	}
-}
 
-func (g *Generator) BuildTypeMap() {
+	// File names are relative to the include path containing them
 	for _, fd := range g.genFiles {
-		data, err := ioutil.ReadFile(fd.Name)
-		if err != nil {
-			g.Error(err)
-		}
-		doc, err := parser.Parse(parser.ParseParams{
-			Source: string(data),
-		})
-		if err != nil {
-			g.Error(err)
+		if fd.url != "" {
+			continue
 		}
-		fd.doc = doc
-		err = buildFileTypeMap(fd)
+		name, err := g.sourceName(fd.path)
 		if err != nil {
 			g.Error(err)
 		}
+		fd.Name = name
+	}
+}
+
+func (g *Generator) BuildTypeMap() {
+	err := g.loadFiles()
+	if err != nil {
+		g.Error(err)
+	}
+	g.reportDiagnostics(g.syntaxErrors)
+
+	g.typeMap = make(typeMap)
+	var diags []*Diagnostic
+	for _, fd := range g.files {
+		diags = append(diags, buildFileTypeMap(g.typeMap, fd)...)
 	}
+	g.reportDiagnostics(diags)
 }
 
 func (g *Generator) BuildTypes() {
-	for _, fd := range g.genFiles {
-		for _, node := range fd.doc.Definitions {
-			switch def := node.(type) {
-			case *ast.SchemaDefinition:
-				desc := fd.typeMap[def.Kind].(*graphqlc.SchemaDescriptorProto)
-				err := buildSchemaDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.FileDescriptorGraphql.Schema = desc
-			case *ast.ScalarDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.ScalarTypeDefinitionDescriptorProto)
-				err := buildScalarsDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Scalars = append(fd.Scalars, desc)
-			case *ast.ObjectDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.ObjectTypeDefinitionDescriptorProto)
-				err := buildObjectDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Objects = append(fd.Objects, desc)
-			case *ast.InterfaceDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.InterfaceTypeDefinitionDescriptorProto)
-				err := buildInterfaceDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Interfaces = append(fd.Interfaces, desc)
-			case *ast.UnionDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.UnionTypeDefinitionDescriptorProto)
-				err := buildUnionDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Unions = append(fd.Unions, desc)
-			case *ast.EnumDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.EnumTypeDefinitionDescriptorProto)
-				err := buildEnumDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Enums = append(fd.Enums, desc)
-			case *ast.InputObjectDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.InputObjectTypeDefinitionDescriptorProto)
-				err := buildInputObjectDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.InputObjects = append(fd.InputObjects, desc)
-			case *ast.DirectiveDefinition:
-				desc := fd.typeMap[def.Name.Value].(*graphqlc.DirectiveDefinitionDescriptorProto)
-				err := buildDirectiveDefinitionDescriptor(fd, desc, def)
-				if err != nil {
-					g.Error(err)
-				}
-				fd.Directives = append(fd.Directives, desc)
-			default:
-				g.Error(fmt.Errorf("%s: unknown type %T", fd.Name, node))
-			}
+	for _, fd := range g.files {
+		switch fd.Name {
+		case builtinFileName:
+			markBuiltin(fd)
+		case directivesFileName:
+			markSynthesized(fd)
 		}
+	}
 
-		if fd.FileDescriptorGraphql.Schema == nil {
-			fd.FileDescriptorGraphql.Schema = &graphqlc.SchemaDescriptorProto{}
-			if desc, ok := fd.typeMap["Query"]; ok {
-				fd.FileDescriptorGraphql.Schema.Query = desc.(*graphqlc.ObjectTypeDefinitionDescriptorProto)
-			} else {
-				queryDef := &graphqlc.ObjectTypeDefinitionDescriptorProto{
-					Name:       "Query",
-					Implements: []*graphqlc.InterfaceTypeDefinitionDescriptorProto{},
-					Directives: []*graphqlc.DirectiveDescriptorProto{},
-					Fields:     []*graphqlc.FieldDefinitionDescriptorProto{},
-				}
-				fd.FileDescriptorGraphql.Objects = append(fd.FileDescriptorGraphql.Objects, queryDef)
-				fd.FileDescriptorGraphql.Schema.Query = queryDef
-			}
-			if desc, ok := fd.typeMap["Mutation"]; ok {
-				fd.FileDescriptorGraphql.Schema.Mutation = desc.(*graphqlc.ObjectTypeDefinitionDescriptorProto)
-			}
-			if desc, ok := fd.typeMap["Subscription"]; ok {
-				fd.FileDescriptorGraphql.Schema.Subscription = desc.(*graphqlc.ObjectTypeDefinitionDescriptorProto)
-			}
+	g.expandConnections()
+	g.buildSchema()
+
+	v := newValidator(g.files, g.schema)
+	for _, fd := range g.files {
+		err := buildExecutableDescriptors(fd, g.typeMap, v)
+		if err != nil {
+			g.Error(err)
 		}
 	}
+
+	for _, fd := range g.files {
+		resolveNamedTypes(g.typeMap, fd)
+		fd.SourceCodeInfo = buildSourceCodeInfo(fd)
+	}
+	// Without a schema definition the schema is not part of any file
+	typeResolver{tm: g.typeMap}.schema(g.schema)
+}
+
+// buildSchema builds the schema of all files. Without a schema definition
+// the root operation types are the types named Query, Mutation and
+// Subscription, if no Query type exists an empty one is added to the first
+// file.
+func (g *Generator) buildSchema() {
+	if desc, ok := g.typeMap.descriptor(schemaKey).(*graphqlc.SchemaDescriptorProto); ok {
+		g.schema = desc
+		return
+	}
+
+	g.schema = &graphqlc.SchemaDescriptorProto{}
+	if _, ok := g.typeMap.object("Query"); ok {
+		g.schema.Query = &graphqlc.NamedTypeDescriptorProto{Name: "Query"}
+	} else if len(g.genFiles) > 0 {
+		queryDef := &graphqlc.ObjectTypeDefinitionDescriptorProto{
+			Name:        "Query",
+			Implements:  []*graphqlc.NamedTypeDescriptorProto{},
+			Directives:  []*graphqlc.DirectiveDescriptorProto{},
+			Fields:      []*graphqlc.FieldDefinitionDescriptorProto{},
+			Synthesized: true,
+		}
+		fd := g.genFiles[0]
+		fd.Objects = append(fd.Objects, queryDef)
+		g.typeMap["Query"] = &definition{desc: queryDef, file: fd}
+		g.schema.Query = &graphqlc.NamedTypeDescriptorProto{Name: "Query"}
+	}
+	if _, ok := g.typeMap.object("Mutation"); ok {
+		g.schema.Mutation = &graphqlc.NamedTypeDescriptorProto{Name: "Mutation"}
+	}
+	if _, ok := g.typeMap.object("Subscription"); ok {
+		g.schema.Subscription = &graphqlc.NamedTypeDescriptorProto{Name: "Subscription"}
+	}
 }
 
 func (g *Generator) GenerateAllFiles() {
+	if len(g.Variants) == 0 {
+		g.generate("")
+		return
+	}
+	g.generateVariants()
+}
+
+// generate runs every plugin, the output of a variant is written to a
+// directory named after the variant in the output directory of each plugin.
+func (g *Generator) generate(variant string) {
 	g.buildRequest()
+	g.Request.Variant = variant
+	g.runTransformers()
+	if g.Prune {
+		g.prune()
+	}
+	if g.DescriptorSetOut != "" {
+		g.writeDescriptorSet(variant)
+	}
 
 	var stdout, stderr bytes.Buffer
 	os.Setenv("PATH", os.Getenv("PATH")+":"+os.Getenv("GOPATH")+"/bin")
@@ -197,6 +276,7 @@ func (g *Generator) GenerateAllFiles() {
 	for suffix, meta := range g.PluginParams {
 		stdout.Reset()
 		g.Request.Parameter = meta.Params
+		path := filepath.Join(meta.Path, variant)
 
 		data, err := proto.Marshal(g.Request)
 		if err != nil {
@@ -226,19 +306,19 @@ func (g *Generator) GenerateAllFiles() {
 					g.Fail("unable to append to file, no previous file exists")
 				}
 				file.Name = g.Response.File[i-1].Name
-				err := appendPreviousFile(meta.Path, file)
+				err := appendPreviousFile(path, file)
 				if err != nil {
 					g.Error(err)
 				}
 			// Write new file
 			case file.Name != "" && file.InsertionPoint == "":
-				err := writeNewFile(meta.Path, file)
+				err := writeNewFile(path, file)
 				if err != nil {
 					g.Error(err)
 				}
 			// Write insertion point
 			case file.Name != "" && file.InsertionPoint != "":
-				err := writeInsertionPoint(meta.Path, file)
+				err := writeInsertionPoint(path, file)
 				if err != nil {
 					g.Error(err)
 				}
@@ -257,474 +337,72 @@ func (g *Generator) buildRequest() {
 		Patch:  GRAPHQLC_VERSION % 1000,
 		Suffix: GRAPHQLC_VERSION_SUFFIX,
 	}
+	if g.MergeExtensions {
+		for _, fd := range g.files {
+			err := mergeTypeExtensions(g.typeMap, g.schema, fd)
+			if err != nil {
+				g.Error(err)
+			}
+		}
+	}
+
+	g.Request.Schema = g.schema
 	for _, fd := range g.genFiles {
 		g.Request.FileToGenerate = append(g.Request.FileToGenerate, fd.Name)
+	}
+	for _, fd := range g.files {
 		g.Request.GraphqlFile = append(g.Request.GraphqlFile, fd.FileDescriptorGraphql)
 	}
 }
 
-func buildFileTypeMap(fd *FileDescriptor) error {
-	fd.typeMap = make(map[string]interface{})
+// buildFileTypeMap adds the definitions of a file to the type map, in
+// document order. The parser built the descriptors of the type system,
+// fragments are built once every type is known.
+func buildFileTypeMap(tm typeMap, fd *FileDescriptor) []*Diagnostic {
+	var diags []*Diagnostic
+	var scalars, objects, interfaces, unions, enums, inputObjects, directives int
 	for _, node := range fd.doc.Definitions {
+		var key string
+		var desc interface{}
 		switch def := node.(type) {
-		case *ast.SchemaDefinition:
-			if _, ok := fd.typeMap[def.Kind]; ok {
-				return fmt.Errorf("multiple `schema` definition")
-			}
-			fd.typeMap[def.Kind] = &graphqlc.SchemaDescriptorProto{}
-
+		case *parser.SchemaDefinition:
+			key, desc = schemaKey, fd.Schema
 		case *ast.ScalarDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.ScalarTypeDefinitionDescriptorProto)
+			key, desc = def.Name.Value, fd.Scalars[scalars]
+			scalars++
 		case *ast.ObjectDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.ObjectTypeDefinitionDescriptorProto)
-		case *ast.InterfaceDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.InterfaceTypeDefinitionDescriptorProto)
+			key, desc = def.Name.Value, fd.Objects[objects]
+			objects++
+		case *parser.InterfaceDefinition:
+			key, desc = def.Name.Value, fd.Interfaces[interfaces]
+			interfaces++
 		case *ast.UnionDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.UnionTypeDefinitionDescriptorProto)
+			key, desc = def.Name.Value, fd.Unions[unions]
+			unions++
 		case *ast.EnumDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.EnumTypeDefinitionDescriptorProto)
+			key, desc = def.Name.Value, fd.Enums[enums]
+			enums++
 		case *ast.InputObjectDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.InputObjectTypeDefinitionDescriptorProto)
-		case *ast.DirectiveDefinition:
-			fd.typeMap[def.Name.Value] = new(graphqlc.DirectiveDefinitionDescriptorProto)
+			key, desc = def.Name.Value, fd.InputObjects[inputObjects]
+			inputObjects++
+		case *parser.DirectiveDefinition:
+			key, desc = directiveKeyPrefix+def.Name.Value, fd.Directives[directives]
+			directives++
+		case *parser.TypeExtension:
+			// Extensions are not types, they are resolved against the types they extend
+			continue
+		case *ast.OperationDefinition:
+			// Operations are not referenced by name
+			continue
+		case *ast.FragmentDefinition:
+			key, desc = fragmentKeyPrefix+def.Name.Value, new(graphqlc.FragmentDescriptorProto)
 		default:
-			return fmt.Errorf("%s: unknown type %T", fd.Name, node)
-		}
-	}
-	return nil
-}
-
-// Top level definitions
-func buildDirectiveDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.DirectiveDefinitionDescriptorProto, node *ast.DirectiveDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	for _, argDef := range node.Arguments {
-		argDesc, err := buildInputValueDefinitionDescriptor(argDef)
-		if err != nil {
-			return err
-		}
-		desc.Arguments = append(desc.Arguments, argDesc)
-	}
-
-	for _, locDef := range node.Locations {
-		if v, ok := graphqlc.ExecutableDirectiveLocation_value[locDef.Value]; ok {
-			desc.Locations = append(desc.Locations, &graphqlc.DirectiveLocationDescriptorProto{
-				Location: &graphqlc.DirectiveLocationDescriptorProto_ExecutableLocation{
-					ExecutableLocation: graphqlc.ExecutableDirectiveLocation(v),
-				},
-			})
-		} else if v, ok := graphqlc.TypeSystemDirectiveLocation_value[locDef.Value]; ok {
-			desc.Locations = append(desc.Locations, &graphqlc.DirectiveLocationDescriptorProto{
-				Location: &graphqlc.DirectiveLocationDescriptorProto_TypeSystemLocation{
-					TypeSystemLocation: graphqlc.TypeSystemDirectiveLocation(v),
-				},
-			})
-		} else {
-			return fmt.Errorf("unknown directive loction %q", locDef.Value)
-		}
-	}
-
-	return nil
-}
-
-func buildEnumDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.EnumTypeDefinitionDescriptorProto, node *ast.EnumDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	for _, enumValDef := range node.Values {
-		directiveDescs, err := buildDirectiveDescriptors(enumValDef.Directives)
-		if err != nil {
-			return err
-		}
-		enumValDesc := &graphqlc.EnumValueDefinitionDescription{
-			Value:      enumValDef.Name.Value,
-			Directives: directiveDescs,
-		}
-		if enumValDef.Description != nil {
-			enumValDesc.Description = enumValDef.Description.Value
-		}
-		desc.Values = append(desc.Values, enumValDesc)
-	}
-
-	return nil
-}
-
-func buildInputObjectDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.InputObjectTypeDefinitionDescriptorProto, node *ast.InputObjectDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	for _, fieldDef := range node.Fields {
-		fieldDesc, err := buildInputValueDefinitionDescriptor(fieldDef)
-		if err != nil {
-			return err
-		}
-		desc.Fields = append(desc.Fields, fieldDesc)
-
-	}
-
-	return nil
-}
-
-func buildInterfaceDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.InterfaceTypeDefinitionDescriptorProto, node *ast.InterfaceDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	for _, fieldDef := range node.Fields {
-		fieldDesc, err := buildFieldDefinitionDescriptor(fieldDef)
-		if err != nil {
-			return err
+			diags = append(diags, newDiagnostic(fd, node.GetLoc(), "unknown type %T", node))
+			continue
 		}
-		desc.Fields = append(desc.Fields, fieldDesc)
-
-	}
-
-	return nil
-}
-
-func buildObjectDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.ObjectTypeDefinitionDescriptorProto, node *ast.ObjectDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	for _, interfaceDef := range node.Interfaces {
-		desc.Implements = append(desc.Implements, fd.typeMap[interfaceDef.Name.Value].(*graphqlc.InterfaceTypeDefinitionDescriptorProto))
-	}
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	for _, fieldDef := range node.Fields {
-		fieldDesc, err := buildFieldDefinitionDescriptor(fieldDef)
-		if err != nil {
-			return err
-		}
-		desc.Fields = append(desc.Fields, fieldDesc)
-
-	}
-
-	return nil
-}
-
-func buildScalarsDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.ScalarTypeDefinitionDescriptorProto, node *ast.ScalarDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	return nil
-}
-
-func buildSchemaDescriptor(fd *FileDescriptor, desc *graphqlc.SchemaDescriptorProto, node *ast.SchemaDefinition) error {
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-	for _, operationType := range node.OperationTypes {
-		typeName := operationType.Type.Name.Value
-		objectDesc := fd.typeMap[typeName].(*graphqlc.ObjectTypeDefinitionDescriptorProto)
-
-		switch operationType.Operation {
-		case "query":
-			desc.Query = objectDesc
-		case "mutation":
-			desc.Mutation = objectDesc
-		case "subscription":
-			desc.Subscription = objectDesc
-		}
-	}
-	return nil
-}
-
-func buildUnionDefinitionDescriptor(fd *FileDescriptor, desc *graphqlc.UnionTypeDefinitionDescriptorProto, node *ast.UnionDefinition) error {
-	if node.Description != nil {
-		desc.Description = node.Description.Value
-	}
-	desc.Name = node.Name.Value
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return err
-	}
-	desc.Directives = directiveDescs
-
-	for _, memberDef := range node.Types {
-		desc.MemberTypes = append(desc.MemberTypes, &graphqlc.NamedTypeDescriptorProto{Name: memberDef.Name.Value})
-	}
-
-	return nil
-}
-
-// Not top level definitions
-func buildFieldDefinitionDescriptor(node *ast.FieldDefinition) (*graphqlc.FieldDefinitionDescriptorProto, error) {
-	fieldDesc := &graphqlc.FieldDefinitionDescriptorProto{
-		Name: node.Name.Value,
-	}
-
-	if node.Description != nil {
-		fieldDesc.Description = node.Description.Value
-	}
-
-	for _, argument := range node.Arguments {
-		if argument != nil {
-			argumentDesc, err := buildInputValueDefinitionDescriptor(argument)
-			if err != nil {
-				return nil, err
-			}
-			fieldDesc.Arguments = append(fieldDesc.Arguments, argumentDesc)
-		}
-	}
-
-	typDesc, err := buildTypeDescriptorProto(node.Type)
-	if err != nil {
-		return nil, err
-	}
-	fieldDesc.Type = &graphqlc.TypeDescriptorProto{Type: typDesc}
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return nil, err
-	}
-	fieldDesc.Directives = directiveDescs
-
-	return fieldDesc, nil
-}
-
-func buildInputValueDefinitionDescriptor(node *ast.InputValueDefinition) (*graphqlc.InputValueDefinitionDescriptorProto, error) {
-	inputValDesc := &graphqlc.InputValueDefinitionDescriptorProto{}
-
-	if node.Name != nil {
-		inputValDesc.Name = node.Name.Value
-	}
-
-	if node.Description != nil {
-		inputValDesc.Description = node.Description.Value
-	}
-
-	typDesc, err := buildTypeDescriptorProto(node.Type)
-	if err != nil {
-		return nil, err
-	}
-	inputValDesc.Type = &graphqlc.TypeDescriptorProto{Type: typDesc}
-
-	if node.DefaultValue != nil {
-		valDesc, err := buildValueDescriptor(node.DefaultValue)
-		if err != nil {
-			return nil, err
-		}
-		inputValDesc.DefaultValue = &graphqlc.ValueDescriptorProto{Value: valDesc}
-	}
-
-	directiveDescs, err := buildDirectiveDescriptors(node.Directives)
-	if err != nil {
-		return nil, err
-	}
-	inputValDesc.Directives = directiveDescs
-
-	return inputValDesc, nil
-}
-
-func buildTypeDescriptorProto(def ast.Type) (graphqlc.TypeDescriptorProto_Type, error) {
-	switch typ := def.(type) {
-	case *ast.Named:
-		return &graphqlc.TypeDescriptorProto_NamedType{
-			NamedType: &graphqlc.NamedTypeDescriptorProto{
-				Name: typ.Name.Value,
-			},
-		}, nil
-	case *ast.List:
-		listTyp, err := buildTypeDescriptorProto(typ.Type)
-		if err != nil {
-			return nil, err
-		}
-		return &graphqlc.TypeDescriptorProto_ListType{
-			ListType: &graphqlc.ListTypeDescriptorProto{
-				Type: &graphqlc.TypeDescriptorProto{
-					Type: listTyp,
-				},
-			},
-		}, nil
-	case *ast.NonNull:
-		typDesc := &graphqlc.TypeDescriptorProto_NonNullType{
-			NonNullType: &graphqlc.NonNullTypeDescriptorProto{},
-		}
-		switch typTypDef := (typ.Type).(type) {
-		case *ast.Named:
-			typDesc.NonNullType.Type = &graphqlc.NonNullTypeDescriptorProto_NamedType{
-				NamedType: &graphqlc.NamedTypeDescriptorProto{
-					Name: typTypDef.Name.Value,
-				},
-			}
-		case *ast.List:
-			listTyp, err := buildTypeDescriptorProto(typTypDef)
-			if err != nil {
-				return nil, err
-			}
-			typDesc.NonNullType.Type = &graphqlc.NonNullTypeDescriptorProto_ListType{
-				ListType: &graphqlc.ListTypeDescriptorProto{
-					Type: &graphqlc.TypeDescriptorProto{
-						Type: listTyp,
-					},
-				},
-			}
-		}
-		return typDesc, nil
-	default:
-		return nil, fmt.Errorf("unknown type %T", def)
-	}
-	return nil, nil
-}
-
-func buildDirectiveDescriptors(directives []*ast.Directive) ([]*graphqlc.DirectiveDescriptorProto, error) {
-	var descriptors []*graphqlc.DirectiveDescriptorProto
-
-	for _, def := range directives {
-		desc, err := buildDirectiveDescriptor(def)
-		if err != nil {
-			return nil, err
-		}
-		descriptors = append(descriptors, desc)
-	}
-
-	return descriptors, nil
-}
-
-func buildDirectiveDescriptor(directive *ast.Directive) (*graphqlc.DirectiveDescriptorProto, error) {
-	directiveDesc := &graphqlc.DirectiveDescriptorProto{
-		Name: directive.Name.Value,
-	}
-
-	for _, argument := range directive.Arguments {
-		argumentDesc, err := buildArgumentDescriptor(argument)
-		if err != nil {
-			return nil, err
-		}
-		directiveDesc.Arguments = append(directiveDesc.Arguments, argumentDesc)
-	}
-
-	return directiveDesc, nil
-}
-
-func buildArgumentDescriptor(argument *ast.Argument) (*graphqlc.ArgumentDescriptorProto, error) {
-	argumentDef := &graphqlc.ArgumentDescriptorProto{
-		Name:  argument.Name.Value,
-		Value: &graphqlc.ValueDescriptorProto{},
-	}
-
-	val, err := buildValueDescriptor(argument.Value)
-	if err != nil {
-		return nil, err
-	}
-	argumentDef.Value.Value = val
-
-	return argumentDef, nil
-}
-
-func buildValueDescriptor(value ast.Value) (graphqlc.ValueDescriptorProto_Value, error) {
-	switch tVal := value.(type) {
-	case *ast.Variable:
-		return &graphqlc.ValueDescriptorProto_VariableValue{
-			VariableValue: &graphqlc.VariableDescriptorProto{
-				Name: tVal.Name.Value,
-			},
-		}, nil
-	case *ast.IntValue:
-		intVal, err := strconv.ParseInt(tVal.Value, 10, 32)
-		if err != nil {
-			return nil, err
-		}
-		return &graphqlc.ValueDescriptorProto_IntValue{
-			IntValue: int32(intVal),
-		}, nil
-	case *ast.FloatValue:
-		floatVal, err := strconv.ParseFloat(tVal.Value, 32)
-		if err != nil {
-			return nil, err
-		}
-		return &graphqlc.ValueDescriptorProto_FloatValue{
-			FloatValue: float32(floatVal),
-		}, nil
-	case *ast.StringValue:
-		return &graphqlc.ValueDescriptorProto_StringValue{
-			StringValue: tVal.Value,
-		}, nil
-	case *ast.BooleanValue:
-		return &graphqlc.ValueDescriptorProto_BooleanValue{
-			BooleanValue: tVal.Value,
-		}, nil
-	case *ast.EnumValue:
-		return &graphqlc.ValueDescriptorProto_EnumValue{
-			EnumValue: &graphqlc.EnumValueDescriptorProto{
-				Value: tVal.Value,
-			},
-		}, nil
-	case *ast.ListValue:
-		listValue := &graphqlc.ValueDescriptorProto_ListValue{
-			ListValue: &graphqlc.ListValueDescriptorProto{},
-		}
-		for _, listV := range tVal.Values {
-			newV, err := buildValueDescriptor(listV)
-			if err != nil {
-				return nil, err
-			}
-			listValue.ListValue.Values = append(listValue.ListValue.Values, &graphqlc.ValueDescriptorProto{Value: newV})
-		}
-		return listValue, nil
-	case *ast.ObjectValue:
-		objValue := &graphqlc.ValueDescriptorProto_ObjectValue{ObjectValue: &graphqlc.ObjectValueDescriptorProto{}}
-		for _, objV := range tVal.Fields {
-			newV, err := buildValueDescriptor(objV.Value)
-			if err != nil {
-				return nil, err
-			}
-			objValue.ObjectValue.Fields = append(objValue.ObjectValue.Fields, &graphqlc.ObjectFieldDescriptorProto{
-				Name:  objV.Name.Value,
-				Value: &graphqlc.ValueDescriptorProto{Value: newV},
-			})
-		}
-
-		return &graphqlc.ValueDescriptorProto_ObjectValue{
-			ObjectValue: &graphqlc.ObjectValueDescriptorProto{},
-		}, nil
+		diags = append(diags, tm.add(key, &definition{desc: desc, file: fd, loc: node.GetLoc()})...)
 	}
-	return nil, fmt.Errorf("unknown value type, %#v\n", value)
+	return diags
 }
 
 // Utility functions
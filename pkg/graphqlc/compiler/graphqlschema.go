@@ -0,0 +1,233 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// graphqlSchemaBuilder builds a graphql-go schema from the types seen by the
+// validator. The schema is only used to validate executable documents, no
+// field is ever resolved.
+type graphqlSchemaBuilder struct {
+	v     *validator
+	types map[string]graphql.Type
+}
+
+func buildGraphqlSchema(v *validator) (graphql.Schema, error) {
+	b := &graphqlSchemaBuilder{
+		v: v,
+		types: map[string]graphql.Type{
+			"Int":     graphql.Int,
+			"Float":   graphql.Float,
+			"String":  graphql.String,
+			"Boolean": graphql.Boolean,
+			"ID":      graphql.ID,
+		},
+	}
+
+	var types []graphql.Type
+	for _, t := range v.typeOrder {
+		if _, ok := b.types[t.name]; !ok {
+			types = append(types, b.namedType(t.name))
+		}
+	}
+
+	config := graphql.SchemaConfig{Types: types}
+	for i, root := range v.roots {
+		if root == nil {
+			continue
+		}
+		object, ok := b.namedType(root.name).(*graphql.Object)
+		if !ok {
+			return graphql.Schema{}, fmt.Errorf("%s root operation type %q is not an object", operationNames[i], root.name)
+		}
+		switch i {
+		case 0:
+			config.Query = object
+		case 1:
+			config.Mutation = object
+		case 2:
+			config.Subscription = object
+		}
+	}
+
+	config.Directives = []*graphql.Directive{
+		graphql.IncludeDirective,
+		graphql.SkipDirective,
+		graphql.DeprecatedDirective,
+	}
+	for _, def := range v.dirOrder {
+		if !def.Builtin {
+			config.Directives = append(config.Directives, b.directive(def))
+		}
+	}
+
+	return graphql.NewSchema(config)
+}
+
+// namedType returns the graphql-go type named name, creating it on first use.
+func (b *graphqlSchemaBuilder) namedType(name string) graphql.Type {
+	if typ, ok := b.types[name]; ok {
+		return typ
+	}
+	t, ok := b.v.types[name]
+	if !ok {
+		return nil
+	}
+
+	var typ graphql.Type
+	switch t.kind {
+	case scalarKind:
+		typ = graphql.NewScalar(graphql.ScalarConfig{
+			Name:         t.name,
+			Serialize:    identity,
+			ParseValue:   identity,
+			ParseLiteral: func(value ast.Value) interface{} { return value },
+		})
+	case objectKind:
+		typ = graphql.NewObject(graphql.ObjectConfig{
+			Name: t.name,
+			Interfaces: graphql.InterfacesThunk(func() []*graphql.Interface {
+				var interfaces []*graphql.Interface
+				for _, ref := range t.interfaces {
+					if iface, ok := b.namedType(ref.name).(*graphql.Interface); ok {
+						interfaces = append(interfaces, iface)
+					}
+				}
+				return interfaces
+			}),
+			Fields: b.fields(t),
+		})
+	case interfaceKind:
+		typ = graphql.NewInterface(graphql.InterfaceConfig{
+			Name:        t.name,
+			Fields:      b.fields(t),
+			ResolveType: resolveNoType,
+		})
+	case unionKind:
+		var members []*graphql.Object
+		for _, ref := range t.members {
+			if object, ok := b.namedType(ref.name).(*graphql.Object); ok {
+				members = append(members, object)
+			}
+		}
+		typ = graphql.NewUnion(graphql.UnionConfig{
+			Name:        t.name,
+			Types:       members,
+			ResolveType: resolveNoType,
+		})
+	case enumKind:
+		values := graphql.EnumValueConfigMap{}
+		for _, value := range t.values {
+			values[value.Value] = &graphql.EnumValueConfig{Value: value.Value}
+		}
+		typ = graphql.NewEnum(graphql.EnumConfig{
+			Name:   t.name,
+			Values: values,
+		})
+	case inputObjectKind:
+		typ = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: t.name,
+			Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+				fields := graphql.InputObjectConfigFieldMap{}
+				for _, f := range t.inputFields {
+					fields[f.Name] = &graphql.InputObjectFieldConfig{
+						Type:         b.typ(f.Type),
+						DefaultValue: defaultValue(f.DefaultValue),
+					}
+				}
+				return fields
+			}),
+		})
+	}
+	b.types[name] = typ
+	return typ
+}
+
+func (b *graphqlSchemaBuilder) fields(t *namedType) graphql.FieldsThunk {
+	return func() graphql.Fields {
+		fields := graphql.Fields{}
+		for _, f := range t.fields {
+			fields[f.Name] = &graphql.Field{
+				Type:              b.typ(f.Type),
+				Args:              b.arguments(f.Arguments),
+				DeprecationReason: deprecationReason(f.Directives),
+			}
+		}
+		return fields
+	}
+}
+
+func (b *graphqlSchemaBuilder) arguments(descs []*graphqlc.InputValueDefinitionDescriptorProto) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{}
+	for _, desc := range descs {
+		args[desc.Name] = &graphql.ArgumentConfig{
+			Type:         b.typ(desc.Type),
+			DefaultValue: defaultValue(desc.DefaultValue),
+		}
+	}
+	return args
+}
+
+func (b *graphqlSchemaBuilder) directive(def *directiveDefinition) *graphql.Directive {
+	var locations []string
+	for _, location := range def.Locations {
+		locations = append(locations, directiveLocationName(location))
+	}
+	return graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      def.Name,
+		Locations: locations,
+		Args:      b.arguments(def.Arguments),
+	})
+}
+
+// typ returns the graphql-go type of a type reference.
+func (b *graphqlSchemaBuilder) typ(desc *graphqlc.TypeDescriptorProto) graphql.Type {
+	switch t := desc.GetType().(type) {
+	case *graphqlc.TypeDescriptorProto_NamedType:
+		return b.namedType(t.NamedType.Name)
+	case *graphqlc.TypeDescriptorProto_ListType:
+		return graphql.NewList(b.typ(t.ListType.Type))
+	case *graphqlc.TypeDescriptorProto_NonNullType:
+		inner, _ := nonNullOf(desc)
+		return graphql.NewNonNull(b.typ(inner))
+	}
+	return nil
+}
+
+// resolveNoType satisfies graphql-go, which requires abstract types can be
+// resolved.
+func resolveNoType(graphql.ResolveTypeParams) *graphql.Object {
+	return nil
+}
+
+func identity(value interface{}) interface{} {
+	return value
+}
+
+// defaultValue returns a non-nil placeholder for a default value, validation
+// only depends on whether there is one.
+func defaultValue(desc *graphqlc.ValueDescriptorProto) interface{} {
+	if desc == nil {
+		return nil
+	}
+	return desc
+}
+
+func deprecationReason(directives []*graphqlc.DirectiveDescriptorProto) string {
+	for _, directive := range directives {
+		if directive.Name != "deprecated" {
+			continue
+		}
+		for _, arg := range directive.Arguments {
+			if arg.Name == "reason" {
+				return arg.Value.GetStringValue()
+			}
+		}
+		return "No longer supported"
+	}
+	return ""
+}
@@ -0,0 +1,239 @@
+package compiler
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// Imports are comments in the leading comment block of a file, before any
+// definition, of the form
+//
+//	# import "common/scalars.graphql"
+//
+// The imported name is relative to an include path. Type names resolve
+// across every file compiled, imported or not, but an operation or fragment
+// only spreads the fragments of its own file and of the files it imports,
+// directly or indirectly.
+var importPattern = regexp.MustCompile(`^#\s*import\s+"([^"]+)"\s*$`)
+
+type importStatement struct {
+	name string
+	line int
+}
+
+// parseImports returns the imports in the leading comment block of a file.
+func parseImports(data []byte) []importStatement {
+	var imports []importStatement
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if line == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if text == "" {
+			continue
+		}
+		if !strings.HasPrefix(text, "#") {
+			break
+		}
+		if match := importPattern.FindStringSubmatch(text); match != nil {
+			imports = append(imports, importStatement{name: match[1], line: line})
+		}
+	}
+	return imports
+}
+
+// sourceName returns the name of the file at path relative to the include
+// path containing it.
+func (g *Generator) sourceName(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	for _, includePath := range g.includePaths() {
+		absInclude, err := filepath.Abs(includePath)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(absInclude, absPath)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return filepath.ToSlash(rel), nil
+	}
+	return "", fmt.Errorf("%s: file does not reside within any path specified using -I or --graphql_path", path)
+}
+
+// findImport returns the path of the file imported as name.
+func (g *Generator) findImport(name string) (string, bool) {
+	for _, includePath := range g.includePaths() {
+		path := filepath.Join(includePath, filepath.FromSlash(name))
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func (g *Generator) includePaths() []string {
+	if len(g.IncludePaths) == 0 {
+		return []string{"."}
+	}
+	return g.IncludePaths
+}
+
+// loadFiles loads the built-ins and directives files, the files to be generated and every
+// file they import. Files are added to g.files after the files they import.
+func (g *Generator) loadFiles() error {
+	loaded := make(map[string]*FileDescriptor)
+
+	builtin := &FileDescriptor{
+		FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{
+			Name: builtinFileName,
+		},
+	}
+	g.parseFile(builtin, []byte(builtinSource))
+	loaded[builtinFileName] = builtin
+	g.builtins = builtinKeys(builtin)
+
+	directives := &FileDescriptor{
+		FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{
+			Name: directivesFileName,
+		},
+	}
+	g.parseFile(directives, []byte(directivesSource))
+	loaded[directivesFileName] = directives
+	for key := range builtinKeys(directives) {
+		g.builtins[key] = true
+	}
+
+	var genFiles []*FileDescriptor
+	for _, fd := range g.genFiles {
+		if _, ok := findFile(genFiles, fd.Name); ok {
+			continue
+		}
+		var err error
+		if fd.url != "" {
+			var data []byte
+			data, err = fetchIntrospection(fd.url, g.IntrospectHeader)
+			if err != nil {
+				return err
+			}
+			fd, err = g.loadSource(fd.Name, fd.url, data, loaded, []string{fd.Name})
+		} else {
+			fd, err = g.loadFile(fd.Name, fd.path, loaded, nil)
+		}
+		if err != nil {
+			return err
+		}
+		genFiles = append(genFiles, fd)
+	}
+	g.genFiles = genFiles
+	return nil
+}
+
+func (g *Generator) loadFile(name, path string, loaded map[string]*FileDescriptor, importing []string) (*FileDescriptor, error) {
+	importing = append(importing, name)
+	if fd, ok := loaded[name]; ok {
+		if fd.doc == nil {
+			return nil, fmt.Errorf("import cycle: %s", strings.Join(importing, " -> "))
+		}
+		return fd, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return g.loadSource(name, path, data, loaded, importing)
+}
+
+// loadSource loads a file given its contents, importing is the chain of
+// files importing it, itself included.
+func (g *Generator) loadSource(name, path string, data []byte, loaded map[string]*FileDescriptor, importing []string) (*FileDescriptor, error) {
+	fd := &FileDescriptor{
+		FileDescriptorGraphql: &graphqlc.FileDescriptorGraphql{
+			Name: name,
+		},
+		path: path,
+	}
+	loaded[name] = fd
+
+	var err error
+	if filepath.Ext(name) == introspectionExt {
+		fd.introspected = true
+		data, err = introspectionSDL(data, g.builtins)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	for _, imp := range parseImports(data) {
+		importPath, ok := g.findImport(imp.name)
+		if !ok {
+			return nil, fmt.Errorf("%s:%d:1: import %q was not found in any include path", name, imp.line, imp.name)
+		}
+		if _, err := g.loadFile(imp.name, importPath, loaded, importing); err != nil {
+			return nil, err
+		}
+		fd.Dependency = append(fd.Dependency, imp.name)
+	}
+
+	g.parseFile(fd, data)
+	return fd, nil
+}
+
+// parseFile parses the contents of a file, adding it to g.files. Syntax
+// errors are collected in g.syntaxErrors, the file holds the definitions
+// parsed without error. Syntax errors of introspection results are in the
+// SDL printed from them, not in the file.
+func (g *Generator) parseFile(fd *FileDescriptor, data []byte) {
+	name := fd.Name
+	if fd.introspected {
+		name += " (as SDL)"
+	}
+	src := source.NewSource(&source.Source{
+		Body: data,
+		Name: name,
+	})
+	file, errs := parser.Parse(src)
+	for _, err := range errs {
+		g.syntaxErrors = append(g.syntaxErrors, newSourceDiagnostic(err.Loc, "%s", err.Message))
+	}
+	fd.body = src.Body
+	fd.doc = file.Document
+	desc := file.Descriptor
+	fd.Schema = desc.Schema
+	fd.Scalars = desc.Scalars
+	fd.Objects = desc.Objects
+	fd.Interfaces = desc.Interfaces
+	fd.Unions = desc.Unions
+	fd.Enums = desc.Enums
+	fd.InputObjects = desc.InputObjects
+	fd.Directives = desc.Directives
+	fd.TypeExtensions = desc.TypeExtensions
+	fd.tokens = file.Tokens
+	fd.comments = file.Comments
+	fd.variableDirectives = file.VariableDirectives
+	buildLocations(fd)
+	g.files = append(g.files, fd)
+}
+
+func findFile(files []*FileDescriptor, name string) (*FileDescriptor, bool) {
+	for _, fd := range files {
+		if fd.Name == name {
+			return fd, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,166 @@
+package compiler
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// importTestGenerator writes files to a temporary directory, returning a
+// generator of a.graphql of the first include path given, relative to it.
+func importTestGenerator(t *testing.T, files map[string]string, includePaths ...string) *Generator {
+	t.Helper()
+	dir := writeTestFiles(t, files)
+	if len(includePaths) == 0 {
+		includePaths = []string{"."}
+	}
+	var args []string
+	for _, path := range includePaths {
+		args = append(args, "-I"+filepath.Join(dir, path))
+	}
+	g := New()
+	g.CommandLineArguments(append(args, filepath.Join(dir, includePaths[0], "a.graphql")))
+	return g
+}
+
+func TestLoadFilesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "import cycle",
+			files: map[string]string{
+				"a.graphql": "# import \"b.graphql\"\ntype Query { b: B }",
+				"b.graphql": "# import \"c.graphql\"\ntype B { c: C }",
+				"c.graphql": "# import \"b.graphql\"\ntype C { b: B }",
+			},
+			wantErr: "import cycle: a.graphql -> b.graphql -> c.graphql -> b.graphql",
+		},
+		{
+			name: "import not found",
+			files: map[string]string{
+				"a.graphql": "# The schema\n# import \"missing.graphql\"\ntype Query { a: Int }",
+			},
+			wantErr: `a.graphql:2:1: import "missing.graphql" was not found in any include path`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := importTestGenerator(t, tt.files).loadFiles()
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("got error %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadFilesImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		wantDeps []string
+	}{
+		{
+			name:     "imports",
+			src:      "# The schema\n\n# import \"b.graphql\"\n#import \"c.graphql\"\ntype Query { b: B c: C }",
+			wantDeps: []string{"b.graphql", "c.graphql"},
+		},
+		{
+			name:     "byte order mark",
+			src:      "\uFEFF# import \"b.graphql\"\ntype Query { b: B }",
+			wantDeps: []string{"b.graphql"},
+		},
+		{
+			name: "import after the first definition",
+			src:  "type Query { a: Int }\n# import \"b.graphql\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := importTestGenerator(t, map[string]string{
+				"a.graphql": tt.src,
+				"b.graphql": "type B { b: Int }",
+				"c.graphql": "type C { c: Int }",
+			})
+			if err := g.loadFiles(); err != nil {
+				t.Fatal(err)
+			}
+			fd, _ := findFile(g.files, "a.graphql")
+			if !reflect.DeepEqual(fd.Dependency, tt.wantDeps) {
+				t.Errorf("got dependencies %v, want %v", fd.Dependency, tt.wantDeps)
+			}
+			var names []string
+			for _, fd := range g.files[2:] {
+				names = append(names, fd.Name)
+			}
+			if want := append(tt.wantDeps, "a.graphql"); !reflect.DeepEqual(names, want) {
+				t.Errorf("got files %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestLoadFilesIncludePaths(t *testing.T) {
+	files := map[string]string{
+		"main/a.graphql":        "# import \"common.graphql\"\ntype Query { c: Common }",
+		"first/common.graphql":  "type Common { first: Int }",
+		"second/common.graphql": "type Common { second: Int }",
+	}
+	tests := []struct {
+		includePaths []string
+		want         string
+	}{
+		{includePaths: []string{"main", "first", "second"}, want: "first"},
+		{includePaths: []string{"main", "second", "first"}, want: "second"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			g := importTestGenerator(t, files, tt.includePaths...)
+			g.BuildTypeMap()
+			g.BuildTypes()
+			common, ok := g.typeMap.object("Common")
+			if !ok {
+				t.Fatal("object Common is not defined")
+			}
+			if got := common.Fields[0].Name; got != tt.want {
+				t.Errorf("got Common.%s, want Common.%s from %s/common.graphql", got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+// TestImportedFragments checks types are resolved across every file
+// compiled, but fragments only across the files imported.
+func TestImportedFragments(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "imported",
+			files: map[string]string{
+				"a.graphql": "# import \"b.graphql\"\n{ ...F }",
+				"b.graphql": "type Query { a: Int }\nfragment F on Query { a }",
+			},
+		},
+		{
+			name: "not imported",
+			files: map[string]string{
+				"a.graphql": "{ ...F }",
+				"b.graphql": "type Query { a: Int }\nfragment F on Query { a }",
+			},
+			want: []string{`a.graphql:1:6: Unknown fragment "F"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, tt.files)
+			if got := diagnosticStrings(g.operationDiagnostics()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
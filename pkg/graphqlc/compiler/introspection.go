@@ -0,0 +1,520 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Files with the extension introspectionExt hold the result of the standard
+// introspection query, either as the whole response or only its data.
+const introspectionExt = ".json"
+
+// introspectionQuery is the introspection query of the October 2021
+// specification. Servers following the June 2018 specification reject the
+// schema description, isRepeatable and specifiedByURL, they are sent
+// legacyIntrospectionQuery instead.
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    description
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      ...FullType
+      specifiedByURL
+    }
+    directives {
+      name
+      description
+      isRepeatable
+      locations
+      args {
+        ...InputValue
+      }
+    }
+  }
+}
+` + introspectionFragments
+
+// legacyIntrospectionQuery is the introspection query of the June 2018
+// specification.
+const legacyIntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      ...FullType
+    }
+    directives {
+      name
+      description
+      locations
+      args {
+        ...InputValue
+      }
+    }
+  }
+}
+` + introspectionFragments
+
+const introspectionFragments = `
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args {
+      ...InputValue
+    }
+    type {
+      ...TypeRef
+    }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields {
+    ...InputValue
+  }
+  interfaces {
+    ...TypeRef
+  }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes {
+    ...TypeRef
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType {
+                kind
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+type introspectionResponse struct {
+	Data   *introspectionData `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+	Schema *introspectionSchema `json:"__schema"`
+}
+
+type introspectionData struct {
+	Schema *introspectionSchema `json:"__schema"`
+}
+
+type introspectionSchema struct {
+	Description      *string                   `json:"description"`
+	QueryType        *introspectionTypeRef     `json:"queryType"`
+	MutationType     *introspectionTypeRef     `json:"mutationType"`
+	SubscriptionType *introspectionTypeRef     `json:"subscriptionType"`
+	Types            []*introspectionType      `json:"types"`
+	Directives       []*introspectionDirective `json:"directives"`
+}
+
+type introspectionType struct {
+	Kind           string                     `json:"kind"`
+	Name           string                     `json:"name"`
+	Description    *string                    `json:"description"`
+	SpecifiedByURL *string                    `json:"specifiedByURL"`
+	Fields         []*introspectionField      `json:"fields"`
+	InputFields    []*introspectionInputValue `json:"inputFields"`
+	Interfaces     []*introspectionTypeRef    `json:"interfaces"`
+	EnumValues     []*introspectionEnumValue  `json:"enumValues"`
+	PossibleTypes  []*introspectionTypeRef    `json:"possibleTypes"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionField struct {
+	Name              string                     `json:"name"`
+	Description       *string                    `json:"description"`
+	Args              []*introspectionInputValue `json:"args"`
+	Type              *introspectionTypeRef      `json:"type"`
+	IsDeprecated      bool                       `json:"isDeprecated"`
+	DeprecationReason *string                    `json:"deprecationReason"`
+}
+
+type introspectionInputValue struct {
+	Name              string                `json:"name"`
+	Description       *string               `json:"description"`
+	Type              *introspectionTypeRef `json:"type"`
+	DefaultValue      *string               `json:"defaultValue"`
+	IsDeprecated      bool                  `json:"isDeprecated"`
+	DeprecationReason *string               `json:"deprecationReason"`
+}
+
+type introspectionEnumValue struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+type introspectionDirective struct {
+	Name         string                     `json:"name"`
+	Description  *string                    `json:"description"`
+	Locations    []string                   `json:"locations"`
+	Args         []*introspectionInputValue `json:"args"`
+	IsRepeatable bool                       `json:"isRepeatable"`
+}
+
+// introspectionSDL converts an introspection result to the SDL it describes.
+// Introspection types and the definitions keyed in builtins are left out.
+func introspectionSDL(data []byte, builtins map[string]bool) ([]byte, error) {
+	var resp introspectionResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	schema := resp.Schema
+	if resp.Data != nil && resp.Data.Schema != nil {
+		schema = resp.Data.Schema
+	}
+	if schema == nil && len(resp.Errors) > 0 {
+		return nil, fmt.Errorf("introspection failed: %s", resp.Errors[0].Message)
+	}
+	if schema == nil {
+		return nil, fmt.Errorf("not an introspection result, __schema is missing")
+	}
+
+	p := &sdlPrinter{}
+	p.schema(schema)
+	for _, t := range schema.Types {
+		if strings.HasPrefix(t.Name, "__") || builtins[t.Name] {
+			continue
+		}
+		if err := p.namedType(t); err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range schema.Directives {
+		if builtins[directiveKeyPrefix+d.Name] {
+			continue
+		}
+		p.directive(d)
+	}
+	return []byte(p.String()), nil
+}
+
+// fetchIntrospection sends the introspection query to the endpoint at
+// rawurl, returning the response. The legacy introspection query is sent if
+// the server rejects the first one.
+func fetchIntrospection(rawurl string, header http.Header) ([]byte, error) {
+	data, err := postIntrospection(rawurl, introspectionQuery, header)
+	if err != nil {
+		return nil, err
+	}
+	var resp introspectionResponse
+	if json.Unmarshal(data, &resp) == nil && len(resp.Errors) > 0 && (resp.Data == nil || resp.Data.Schema == nil) {
+		return postIntrospection(rawurl, legacyIntrospectionQuery, header)
+	}
+	return data, nil
+}
+
+// postIntrospection sends query to the endpoint at rawurl, returning the
+// response.
+func postIntrospection(rawurl, query string, header http.Header) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"query":         query,
+		"operationName": "IntrospectionQuery",
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, rawurl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("%s: introspection failed: %s", rawurl, resp.Status)
+	}
+	return data, nil
+}
+
+// introspectionName returns the name of the file introspected from rawurl,
+// its host and path, e.g. api.example.com/graphql.json.
+func introspectionName(rawurl string) (string, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("%s: introspection requires an http or https URL", rawurl)
+	}
+	name := strings.Replace(u.Host, ":", "_", -1)
+	if p := path.Clean("/" + u.Path); p != "/" {
+		name += p
+	}
+	return name + introspectionExt, nil
+}
+
+// sdlPrinter prints the SDL of an introspection result, one definition
+// after another.
+type sdlPrinter struct {
+	strings.Builder
+}
+
+// schema prints the schema definition, unless the root operation types are
+// the ones used without one.
+func (p *sdlPrinter) schema(schema *introspectionSchema) {
+	defined := make(map[string]bool)
+	for _, t := range schema.Types {
+		defined[t.Name] = true
+	}
+	roots := [3]*introspectionTypeRef{schema.QueryType, schema.MutationType, schema.SubscriptionType}
+	conventional := [3]string{"Query", "Mutation", "Subscription"}
+
+	implicit := true
+	for i, root := range roots {
+		name := ""
+		if root != nil {
+			name = root.Name
+		}
+		if i == 0 && name != conventional[i] {
+			implicit = false
+		}
+		if i > 0 && name != conventional[i] && (name != "" || defined[conventional[i]]) {
+			implicit = false
+		}
+	}
+	if implicit && (schema.Description == nil || *schema.Description == "") {
+		return
+	}
+
+	p.description("", schema.Description)
+	p.WriteString("schema {\n")
+	for i, root := range roots {
+		if root != nil {
+			fmt.Fprintf(p, "  %s: %s\n", operationNames[i], root.Name)
+		}
+	}
+	p.WriteString("}\n\n")
+}
+
+func (p *sdlPrinter) namedType(t *introspectionType) error {
+	p.description("", t.Description)
+	switch t.Kind {
+	case "SCALAR":
+		fmt.Fprintf(p, "scalar %s", t.Name)
+		if t.SpecifiedByURL != nil {
+			fmt.Fprintf(p, " @specifiedBy(url: %s)", quoteString(*t.SpecifiedByURL))
+		}
+		p.WriteString("\n")
+	case "OBJECT", "INTERFACE":
+		if t.Kind == "OBJECT" {
+			fmt.Fprintf(p, "type %s", t.Name)
+		} else {
+			fmt.Fprintf(p, "interface %s", t.Name)
+		}
+		for i, iface := range t.Interfaces {
+			if i == 0 {
+				p.WriteString(" implements ")
+			} else {
+				p.WriteString(" & ")
+			}
+			p.WriteString(iface.Name)
+		}
+		p.WriteString(" {\n")
+		for _, f := range t.Fields {
+			p.description("  ", f.Description)
+			fmt.Fprintf(p, "  %s", f.Name)
+			p.arguments("  ", f.Args)
+			fmt.Fprintf(p, ": %s", typeRefString(f.Type))
+			p.deprecated(f.IsDeprecated, f.DeprecationReason)
+			p.WriteString("\n")
+		}
+		p.WriteString("}\n")
+	case "UNION":
+		fmt.Fprintf(p, "union %s", t.Name)
+		for i, member := range t.PossibleTypes {
+			if i == 0 {
+				p.WriteString(" = ")
+			} else {
+				p.WriteString(" | ")
+			}
+			p.WriteString(member.Name)
+		}
+		p.WriteString("\n")
+	case "ENUM":
+		fmt.Fprintf(p, "enum %s {\n", t.Name)
+		for _, value := range t.EnumValues {
+			p.description("  ", value.Description)
+			fmt.Fprintf(p, "  %s", value.Name)
+			p.deprecated(value.IsDeprecated, value.DeprecationReason)
+			p.WriteString("\n")
+		}
+		p.WriteString("}\n")
+	case "INPUT_OBJECT":
+		fmt.Fprintf(p, "input %s {\n", t.Name)
+		for _, f := range t.InputFields {
+			p.WriteString("  ")
+			p.inputValue("  ", f)
+			p.WriteString("\n")
+		}
+		p.WriteString("}\n")
+	default:
+		return fmt.Errorf("type %q is of unknown kind %q", t.Name, t.Kind)
+	}
+	p.WriteString("\n")
+	return nil
+}
+
+func (p *sdlPrinter) directive(d *introspectionDirective) {
+	p.description("", d.Description)
+	fmt.Fprintf(p, "directive @%s", d.Name)
+	p.arguments("", d.Args)
+	if d.IsRepeatable {
+		p.WriteString(" repeatable")
+	}
+	fmt.Fprintf(p, " on %s\n\n", strings.Join(d.Locations, " | "))
+}
+
+// arguments prints arguments one per line, so each can have a description.
+func (p *sdlPrinter) arguments(indent string, args []*introspectionInputValue) {
+	if len(args) == 0 {
+		return
+	}
+	p.WriteString("(\n")
+	for _, arg := range args {
+		p.WriteString(indent + "  ")
+		p.inputValue(indent+"  ", arg)
+		p.WriteString("\n")
+	}
+	p.WriteString(indent + ")")
+}
+
+func (p *sdlPrinter) inputValue(indent string, v *introspectionInputValue) {
+	if v.Description != nil && *v.Description != "" {
+		fmt.Fprintf(p, "%s\n%s", quoteString(*v.Description), indent)
+	}
+	fmt.Fprintf(p, "%s: %s", v.Name, typeRefString(v.Type))
+	if v.DefaultValue != nil {
+		fmt.Fprintf(p, " = %s", *v.DefaultValue)
+	}
+	p.deprecated(v.IsDeprecated, v.DeprecationReason)
+}
+
+func (p *sdlPrinter) description(indent string, description *string) {
+	if description != nil && *description != "" {
+		fmt.Fprintf(p, "%s%s\n", indent, quoteString(*description))
+	}
+}
+
+func (p *sdlPrinter) deprecated(isDeprecated bool, reason *string) {
+	if !isDeprecated {
+		return
+	}
+	if reason == nil || *reason == "No longer supported" {
+		p.WriteString(" @deprecated")
+		return
+	}
+	fmt.Fprintf(p, " @deprecated(reason: %s)", quoteString(*reason))
+}
+
+func typeRefString(ref *introspectionTypeRef) string {
+	if ref == nil {
+		return ""
+	}
+	switch ref.Kind {
+	case "NON_NULL":
+		return typeRefString(ref.OfType) + "!"
+	case "LIST":
+		return "[" + typeRefString(ref.OfType) + "]"
+	}
+	return ref.Name
+}
+
+// quoteString returns s as a GraphQL string value.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
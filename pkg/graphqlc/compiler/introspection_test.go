@@ -0,0 +1,234 @@
+package compiler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	gqlparser "github.com/graphql-go/graphql/language/parser"
+)
+
+// introspectionResult is the result of the introspection query for
+//
+//	type Query implements Node { a: Int }
+//	interface Node { id: ID! }
+//
+// Query does not implement Node.
+const introspectionResult = `{"data": {"__schema": {
+  "queryType": {"name": "Query"},
+  "types": [
+    {"kind": "OBJECT", "name": "Query",
+     "fields": [{"name": "a", "args": [], "type": {"kind": "SCALAR", "name": "Int"}}],
+     "interfaces": [{"kind": "INTERFACE", "name": "Node"}]},
+    {"kind": "INTERFACE", "name": "Node",
+     "fields": [{"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}],
+     "interfaces": [],
+     "possibleTypes": [{"kind": "OBJECT", "name": "Query"}]}
+  ],
+  "directives": []
+}}}`
+
+func TestIntrospectionResultPositions(t *testing.T) {
+	g := buildTestGenerator(t, map[string]string{"schema.json": introspectionResult})
+	fd, ok := findFile(g.files, "schema.json")
+	if !ok {
+		t.Fatal("schema.json: file not found")
+	}
+	if fd.SourceCodeInfo != nil {
+		t.Errorf("got SourceCodeInfo %v, want none", fd.SourceCodeInfo)
+	}
+
+	v := newValidator(g.files, g.schema)
+	v.validate()
+	want := []string{`schema.json: Query.id expected by interface "Node" is not provided`}
+	if got := diagnosticStrings(v.diags); !reflect.DeepEqual(got, want) {
+		t.Errorf("got diagnostics %q, want %q", got, want)
+	}
+}
+
+func TestFetchIntrospection(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:   "result",
+			status: http.StatusOK,
+			body:   introspectionResult,
+		},
+		{
+			name:    "HTTP error",
+			status:  http.StatusForbidden,
+			body:    `{"errors": [{"message": "forbidden"}]}`,
+			wantErr: "introspection failed: 403 Forbidden",
+		},
+		{
+			name:    "GraphQL errors",
+			status:  http.StatusOK,
+			body:    `{"data": null, "errors": [{"message": "introspection is disabled"}]}`,
+			wantErr: "introspection failed: introspection is disabled",
+		},
+		{
+			name:    "not an introspection result",
+			status:  http.StatusOK,
+			body:    `{"data": {"hero": null}}`,
+			wantErr: "not an introspection result, __schema is missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Query         string `json:"query"`
+					OperationName string `json:"operationName"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("decoding request: %v", err)
+				}
+				if r.Method != http.MethodPost || req.Query != introspectionQuery && req.Query != legacyIntrospectionQuery || req.OperationName != "IntrospectionQuery" {
+					t.Errorf("got %s request for operation %q, want the introspection query", r.Method, req.OperationName)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer token" {
+					t.Errorf("got Authorization header %q, want %q", got, "Bearer token")
+				}
+				w.WriteHeader(tt.status)
+				io.WriteString(w, tt.body)
+			}))
+			defer srv.Close()
+
+			data, err := fetchIntrospection(srv.URL, http.Header{"Authorization": {"Bearer token"}})
+			if err == nil {
+				_, err = introspectionSDL(data, nil)
+			}
+			var got string
+			if err != nil {
+				got = strings.TrimPrefix(err.Error(), srv.URL+": ")
+			}
+			if got != tt.wantErr {
+				t.Errorf("got error %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntrospect(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, introspectionResult)
+	}))
+	defer srv.Close()
+
+	g := New()
+	g.CommandLineArguments([]string{"--introspect=" + srv.URL + "/graphql"})
+	g.BuildTypeMap()
+	g.BuildTypes()
+
+	name := strings.Replace(strings.TrimPrefix(srv.URL, "http://"), ":", "_", -1) + "/graphql.json"
+	if len(g.genFiles) != 1 || g.genFiles[0].Name != name {
+		t.Fatalf("got files to generate %v, want %s", g.genFiles, name)
+	}
+	if _, ok := g.typeMap.object("Query"); !ok {
+		t.Errorf("object Query not introspected")
+	}
+	if g.schema.GetQuery().GetName() != "Query" {
+		t.Errorf("got query root operation type %q, want Query", g.schema.GetQuery().GetName())
+	}
+}
+
+// graphqlGoSchema returns a schema served by graphql-go, which implements
+// the introspection of the June 2018 specification.
+func graphqlGoSchema(t *testing.T) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: graphql.Fields{"a": &graphql.Field{Type: graphql.Int}},
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func TestIntrospectionQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "June 2018",
+			query: legacyIntrospectionQuery,
+		},
+		{
+			name:  "October 2021",
+			query: introspectionQuery,
+			want: []string{
+				`Cannot query field "description" on type "__Schema".`,
+				`Cannot query field "isRepeatable" on type "__Directive".`,
+				`Cannot query field "specifiedByURL" on type "__Type".`,
+			},
+		},
+	}
+	schema := graphqlGoSchema(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := gqlparser.Parse(gqlparser.ParseParams{Source: tt.query})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Suggestions are left out, graphql-go orders them randomly
+			var got []string
+			for _, err := range graphql.ValidateDocument(&schema, doc, nil).Errors {
+				got = append(got, strings.SplitAfter(err.Message, `".`)[0])
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchIntrospectionLegacy(t *testing.T) {
+	schema := graphqlGoSchema(t)
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query         string `json:"query"`
+			OperationName string `json:"operationName"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		queries = append(queries, req.Query)
+		json.NewEncoder(w).Encode(graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: req.Query,
+			OperationName: req.OperationName,
+		}))
+	}))
+	defer srv.Close()
+
+	data, err := fetchIntrospection(srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{introspectionQuery, legacyIntrospectionQuery}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("got %d queries, want the introspection query then the legacy one", len(queries))
+	}
+	sdl, err := introspectionSDL(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "type Query {\n  a: Int\n}\n"; !strings.Contains(string(sdl), want) {
+		t.Errorf("got SDL\n%s\nwant it to contain\n%s", sdl, want)
+	}
+}
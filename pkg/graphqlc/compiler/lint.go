@@ -0,0 +1,395 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// lintKind is the kind of a definition checked by lint rules.
+type lintKind string
+
+const (
+	lintType       lintKind = "type"
+	lintField      lintKind = "field"
+	lintArgument   lintKind = "argument"
+	lintInputField lintKind = "input field"
+	lintEnumValue  lintKind = "enum value"
+)
+
+// lintDefinition is a definition checked by lint rules. Coordinate names it
+// in messages, e.g. User, User.email, Query.users(first:) or Role.ADMIN.
+type lintDefinition struct {
+	kind        lintKind
+	typeKind    typeKind // the kind of a type
+	name        string
+	coordinate  string
+	description string
+	directives  []*graphqlc.DirectiveDescriptorProto
+	site        site
+}
+
+// lintRule is a rule of graphqlc lint. check returns the violation of the
+// rule by a definition, empty if there is none. options returns the default
+// options of the rule, a pointer to the struct its JSON configuration is
+// decoded into, which check is given.
+type lintRule struct {
+	name    string
+	options func() interface{}
+	check   func(def *lintDefinition, options interface{}) string
+}
+
+type typeDescriptionOptions struct {
+	Kinds []string `json:"kinds"` // kinds of the types checked, e.g. "object" or "input object"
+}
+
+type outputTypeSuffixOptions struct {
+	Suffixes []string `json:"suffixes"`
+}
+
+var (
+	pascalCase         = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+	camelCase          = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	screamingSnakeCase = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+)
+
+// lintRules are the rules of graphqlc lint, all enabled unless configured
+// otherwise.
+var lintRules = []*lintRule{
+	{
+		name: "type-name-case",
+		check: func(def *lintDefinition, _ interface{}) string {
+			if def.kind == lintType && !pascalCase.MatchString(def.name) {
+				return fmt.Sprintf("%s %s is not PascalCase", def.typeKind, def.coordinate)
+			}
+			return ""
+		},
+	},
+	{
+		name: "field-name-case",
+		check: func(def *lintDefinition, _ interface{}) string {
+			switch def.kind {
+			case lintField, lintArgument, lintInputField:
+				if !camelCase.MatchString(def.name) {
+					return fmt.Sprintf("%s %s is not camelCase", def.kind, def.coordinate)
+				}
+			}
+			return ""
+		},
+	},
+	{
+		name: "enum-value-case",
+		check: func(def *lintDefinition, _ interface{}) string {
+			if def.kind == lintEnumValue && !screamingSnakeCase.MatchString(def.name) {
+				return fmt.Sprintf("enum value %s is not SCREAMING_SNAKE_CASE", def.coordinate)
+			}
+			return ""
+		},
+	},
+	{
+		name: "type-description",
+		options: func() interface{} {
+			return &typeDescriptionOptions{Kinds: []string{"scalar", "object", "interface", "union", "enum", "input object"}}
+		},
+		check: func(def *lintDefinition, options interface{}) string {
+			kinds := options.(*typeDescriptionOptions).Kinds
+			if def.kind == lintType && containsString(kinds, def.typeKind.String()) && strings.TrimSpace(def.description) == "" {
+				return fmt.Sprintf("%s %s has no description", def.typeKind, def.coordinate)
+			}
+			return ""
+		},
+	},
+	{
+		name: "output-type-suffix",
+		options: func() interface{} {
+			return &outputTypeSuffixOptions{Suffixes: []string{"Input"}}
+		},
+		check: func(def *lintDefinition, options interface{}) string {
+			if def.kind != lintType || !def.typeKind.isComposite() {
+				return ""
+			}
+			for _, suffix := range options.(*outputTypeSuffixOptions).Suffixes {
+				if strings.HasSuffix(def.name, suffix) {
+					return fmt.Sprintf("%s %s is an output type, its name must not end in %q", def.typeKind, def.coordinate, suffix)
+				}
+			}
+			return ""
+		},
+	},
+	{
+		name: "deprecation-reason",
+		check: func(def *lintDefinition, _ interface{}) string {
+			desc := findDirective(def.directives, "deprecated")
+			if desc == nil {
+				return ""
+			}
+			reason := directiveArgument(desc, "reason")
+			if reason == nil || reason.Defaulted || strings.TrimSpace(reason.Value.GetStringValue()) == "" {
+				return fmt.Sprintf("%s %s is deprecated without a reason", def.kind, def.coordinate)
+			}
+			return ""
+		},
+	},
+}
+
+// lintConfig is the file given by --lint_config, e.g.
+//
+//	{
+//	  "rules": {
+//	    "type-description": {"kinds": ["object", "interface"]},
+//	    "output-type-suffix": {"suffixes": ["Input", "Args"]},
+//	    "field-name-case": false
+//	  }
+//	}
+//
+// A rule is disabled with false and configured with an object of its
+// options. Every rule not configured is enabled with its default options.
+type lintConfig struct {
+	Rules map[string]json.RawMessage `json:"rules"`
+}
+
+// lintCheck is a rule enabled and its options.
+type lintCheck struct {
+	rule    *lintRule
+	options interface{}
+}
+
+// loadLintChecks returns the rules enabled by the configuration at path,
+// every rule if path is empty.
+func loadLintChecks(path string) ([]*lintCheck, error) {
+	config := new(lintConfig)
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	for name := range config.Rules {
+		if findLintRule(name) == nil {
+			return nil, fmt.Errorf("%s: unknown lint rule %q", path, name)
+		}
+	}
+
+	var checks []*lintCheck
+	for _, rule := range lintRules {
+		c := &lintCheck{rule: rule, options: &struct{}{}}
+		if rule.options != nil {
+			c.options = rule.options()
+		}
+		raw, ok := config.Rules[rule.name]
+		switch {
+		case !ok || string(raw) == "true":
+		case string(raw) == "false":
+			continue
+		default:
+			dec := json.NewDecoder(bytes.NewReader(raw))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(c.options); err != nil {
+				return nil, fmt.Errorf("%s: lint rule %s: %s", path, rule.name, err)
+			}
+		}
+		checks = append(checks, c)
+	}
+	return checks, nil
+}
+
+func findLintRule(name string) *lintRule {
+	for _, rule := range lintRules {
+		if rule.name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Lint runs graphqlc lint, checking the files given against the lint rules
+// and reporting every violation as compile errors are, exiting the program
+// with status 1 if there are any.
+//
+//	graphqlc lint [-I PATH]... [--error_format=gcc|msvs] [--lint_config=FILE] FILES...
+//
+// The files they import are only checked if they are given too. A comment
+// before a definition, or on the line it ends on,
+//
+//	# graphqlc:lint-ignore field-name-case enum-value-case
+//
+// suppresses the rules it names for the definition and everything in it,
+// every rule if it names none.
+func Lint(arguments []string) {
+	var args []string
+	configPath := ""
+	for _, arg := range arguments {
+		if strings.HasPrefix(arg, "--lint_config=") {
+			configPath = strings.TrimPrefix(arg, "--lint_config=")
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	g := New()
+	g.CommandLineArguments(args)
+	if len(g.PluginParams) > 0 || len(g.Transformers) > 0 {
+		g.Error(fmt.Errorf("lint runs no plugins"))
+	}
+	checks, err := loadLintChecks(configPath)
+	if err != nil {
+		g.Error(err)
+	}
+	g.BuildTypeMap()
+	g.BuildTypes()
+	g.ValidateTypes()
+
+	l := &linter{checks: checks, files: make(map[string]int), comments: make(map[*FileDescriptor]map[string][]string)}
+	for i, fd := range g.genFiles {
+		l.files[fd.Name] = i
+	}
+	l.lint(newValidator(g.files, g.schema))
+	g.reportDiagnostics(l.diags)
+}
+
+// linter checks the definitions of the files given to graphqlc lint.
+type linter struct {
+	checks   []*lintCheck
+	files    map[string]int                          // Names of the files checked, in command line order
+	comments map[*FileDescriptor]map[string][]string // Comments of each file by path
+	diags    []*Diagnostic
+}
+
+func (l *linter) lint(v *validator) {
+	for _, t := range v.typeOrder {
+		if t.synthetic || isSynthesizedObject(t.site) {
+			continue
+		}
+		l.check(&lintDefinition{
+			kind:        lintType,
+			typeKind:    t.kind,
+			name:        t.name,
+			coordinate:  t.name,
+			description: t.description,
+			site:        t.site,
+		})
+		for _, f := range t.fields {
+			coordinate := t.name + "." + f.Name
+			l.check(&lintDefinition{
+				kind:        lintField,
+				name:        f.Name,
+				coordinate:  coordinate,
+				description: f.Description,
+				directives:  f.Directives,
+				site:        f.site,
+			})
+			for _, arg := range f.argumentValues() {
+				l.checkInputValue(lintArgument, coordinate+"("+arg.Name+":)", arg)
+			}
+		}
+		for _, f := range t.inputFields {
+			l.checkInputValue(lintInputField, t.name+"."+f.Name, f)
+		}
+		for _, value := range t.values {
+			l.check(&lintDefinition{
+				kind:        lintEnumValue,
+				name:        value.Value,
+				coordinate:  t.name + "." + value.Value,
+				description: value.Description,
+				directives:  value.Directives,
+				site:        value.site,
+			})
+		}
+	}
+	for _, def := range v.dirOrder {
+		for _, arg := range def.argumentValues() {
+			l.checkInputValue(lintArgument, "@"+def.Name+"("+arg.Name+":)", arg)
+		}
+	}
+
+	sort.SliceStable(l.diags, func(i, j int) bool {
+		a, b := l.diags[i], l.diags[j]
+		if a.File != b.File {
+			return l.files[a.File] < l.files[b.File]
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+func (l *linter) checkInputValue(kind lintKind, coordinate string, value *inputValue) {
+	if value.Synthesized {
+		return
+	}
+	l.check(&lintDefinition{
+		kind:        kind,
+		name:        value.Name,
+		coordinate:  coordinate,
+		description: value.Description,
+		directives:  value.Directives,
+		site:        value.site,
+	})
+}
+
+// check reports every violation by a definition of the files checked.
+func (l *linter) check(def *lintDefinition) {
+	if def.site.fd == nil {
+		return
+	}
+	if _, ok := l.files[def.site.fd.Name]; !ok {
+		return
+	}
+	for _, c := range l.checks {
+		message := c.rule.check(def, c.options)
+		if message == "" || l.ignored(def.site, c.rule.name) {
+			continue
+		}
+		fd := def.site.fd
+		l.diags = append(l.diags, newDiagnostic(fd, fd.locations.find(def.site.path), "%s [%s]", message, c.rule.name))
+	}
+}
+
+const lintIgnoreComment = "graphqlc:lint-ignore"
+
+// ignored reports whether the leading or trailing comments of the
+// definition at s, or of a definition enclosing it, suppress a rule.
+func (l *linter) ignored(s site, rule string) bool {
+	comments, ok := l.comments[s.fd]
+	if !ok {
+		comments = make(map[string][]string)
+		for _, loc := range s.fd.GetSourceCodeInfo().GetLocation() {
+			key := pathKey(loc.Path)
+			comments[key] = append(comments[key], loc.LeadingComments, loc.TrailingComments)
+		}
+		l.comments[s.fd] = comments
+	}
+	for n := len(s.path); n > 0; n-- {
+		for _, text := range comments[pathKey(s.path[:n])] {
+			for _, line := range strings.Split(text, "\n") {
+				fields := strings.Fields(line)
+				if len(fields) == 0 || fields[0] != lintIgnoreComment {
+					continue
+				}
+				if len(fields) == 1 || containsString(fields[1:], rule) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isSynthesizedObject reports whether the type at s is an object added by
+// graphqlc, such as the objects of a Relay connection.
+func isSynthesizedObject(s site) bool {
+	if s.fd == nil || len(s.path) != 2 || s.path[0] != fileObjectsField {
+		return false
+	}
+	return s.fd.Objects[s.path[1]].Synthesized
+}
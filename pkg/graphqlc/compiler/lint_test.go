@@ -0,0 +1,232 @@
+package compiler
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// lintTestFile lints a file named schema.graphql, src, with the rules
+// enabled by config, every rule if config is empty. rules restricts the
+// checks to the rules named.
+func lintTestFile(t *testing.T, config, src string, rules ...string) []string {
+	t.Helper()
+	path := ""
+	if config != "" {
+		path = writeTestConfig(t, "lint.json", config)
+	}
+	checks, err := loadLintChecks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) > 0 {
+		var selected []*lintCheck
+		for _, c := range checks {
+			if containsString(rules, c.rule.name) {
+				selected = append(selected, c)
+			}
+		}
+		checks = selected
+	}
+
+	g := buildTestGenerator(t, map[string]string{"schema.graphql": src})
+	l := &linter{checks: checks, files: map[string]int{"schema.graphql": 0}, comments: make(map[*FileDescriptor]map[string][]string)}
+	l.lint(newValidator(g.files, g.schema))
+	return diagnosticStrings(l.diags)
+}
+
+// writeTestConfig writes a configuration file to a temporary directory,
+// returning its path.
+func writeTestConfig(t *testing.T, name, data string) string {
+	t.Helper()
+	return filepath.Join(writeTestFiles(t, map[string]string{name: data}), name)
+}
+
+func TestLintRules(t *testing.T) {
+	tests := []struct {
+		rule string
+		name string
+		src  string
+		want []string
+	}{
+		{
+			rule: "type-name-case",
+			name: "PascalCase",
+			src:  "type Query { a: UserProfile }\ntype UserProfile { a: Int }",
+		},
+		{
+			rule: "type-name-case",
+			name: "snake_case",
+			src:  "type Query { a: user_profile }\ntype user_profile { a: Int }",
+			want: []string{"schema.graphql:2:1: object user_profile is not PascalCase [type-name-case]"},
+		},
+		{
+			rule: "field-name-case",
+			name: "camelCase",
+			src:  "type Query { userName(firstName: String): String }",
+		},
+		{
+			rule: "field-name-case",
+			name: "snake_case",
+			src:  "type Query { user_name(first_name: String): String }",
+			want: []string{
+				"schema.graphql:1:14: field Query.user_name is not camelCase [field-name-case]",
+				"schema.graphql:1:24: argument Query.user_name(first_name:) is not camelCase [field-name-case]",
+			},
+		},
+		{
+			rule: "enum-value-case",
+			name: "SCREAMING_SNAKE_CASE",
+			src:  "type Query { a: Role }\nenum Role { SUPER_ADMIN }",
+		},
+		{
+			rule: "enum-value-case",
+			name: "camelCase",
+			src:  "type Query { a: Role }\nenum Role { superAdmin }",
+			want: []string{"schema.graphql:2:13: enum value Role.superAdmin is not SCREAMING_SNAKE_CASE [enum-value-case]"},
+		},
+		{
+			rule: "type-description",
+			name: "described",
+			src:  "\"The root\"\ntype Query { a: Int }",
+		},
+		{
+			rule: "type-description",
+			name: "undescribed",
+			src:  "type Query { a: Int }",
+			want: []string{"schema.graphql:1:1: object Query has no description [type-description]"},
+		},
+		{
+			rule: "output-type-suffix",
+			name: "input object",
+			src:  "type Query { a(u: UserInput): Int }\ninput UserInput { a: Int }",
+		},
+		{
+			rule: "output-type-suffix",
+			name: "object",
+			src:  "type Query { a: UserInput }\ntype UserInput { a: Int }",
+			want: []string{`schema.graphql:2:1: object UserInput is an output type, its name must not end in "Input" [output-type-suffix]`},
+		},
+		{
+			rule: "deprecation-reason",
+			name: "reason",
+			src:  "type Query { a: Int @deprecated(reason: \"Use b.\") b: Int }",
+		},
+		{
+			rule: "deprecation-reason",
+			name: "default reason",
+			src:  "type Query { a: Int @deprecated b: Int }",
+			want: []string{"schema.graphql:1:14: field Query.a is deprecated without a reason [deprecation-reason]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule+" "+tt.name, func(t *testing.T) {
+			if got := lintTestFile(t, "", tt.src, tt.rule); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintConfig(t *testing.T) {
+	const src = "type Query { user_name: Role }\nenum Role { admin }"
+	tests := []struct {
+		name   string
+		config string
+		want   []string
+	}{
+		{
+			name: "every rule",
+			want: []string{
+				"schema.graphql:1:1: object Query has no description [type-description]",
+				"schema.graphql:1:14: field Query.user_name is not camelCase [field-name-case]",
+				"schema.graphql:2:1: enum Role has no description [type-description]",
+				"schema.graphql:2:13: enum value Role.admin is not SCREAMING_SNAKE_CASE [enum-value-case]",
+			},
+		},
+		{
+			name:   "rule disabled",
+			config: `{"rules": {"field-name-case": false, "type-description": false}}`,
+			want:   []string{"schema.graphql:2:13: enum value Role.admin is not SCREAMING_SNAKE_CASE [enum-value-case]"},
+		},
+		{
+			name:   "rule options",
+			config: `{"rules": {"field-name-case": false, "enum-value-case": false, "type-description": {"kinds": ["enum"]}}}`,
+			want:   []string{"schema.graphql:2:1: enum Role has no description [type-description]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lintTestFile(t, tt.config, src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "unknown rule",
+			config:  `{"rules": {"no-such-rule": false}}`,
+			wantErr: `lint.json: unknown lint rule "no-such-rule"`,
+		},
+		{
+			name:    "unknown option",
+			config:  `{"rules": {"type-description": {"kind": ["enum"]}}}`,
+			wantErr: `lint.json: lint rule type-description: json: unknown field "kind"`,
+		},
+		{
+			name:    "invalid JSON",
+			config:  `{"rules": `,
+			wantErr: "lint.json: unexpected end of JSON input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestConfig(t, "lint.json", tt.config)
+			_, err := loadLintChecks(path)
+			if err == nil || err.Error() != filepath.Join(filepath.Dir(path), tt.wantErr) {
+				t.Errorf("got error %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLintIgnoreComment(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "rule named",
+			src:  "type Query {\n  # graphqlc:lint-ignore field-name-case\n  user_name: Int\n  user_id: Int\n}",
+			want: []string{"schema.graphql:4:3: field Query.user_id is not camelCase [field-name-case]"},
+		},
+		{
+			name: "other rule named",
+			src:  "type Query {\n  # graphqlc:lint-ignore enum-value-case\n  user_name: Int\n}",
+			want: []string{"schema.graphql:3:3: field Query.user_name is not camelCase [field-name-case]"},
+		},
+		{
+			name: "every rule",
+			src:  "type Query {\n  user_name: Int # graphqlc:lint-ignore\n}",
+		},
+		{
+			name: "enclosing definition",
+			src:  "# graphqlc:lint-ignore field-name-case\ntype Query {\n  user_name(first_name: String): Int\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lintTestFile(t, "", tt.src, "field-name-case"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,517 @@
+package compiler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// Field numbers of the descriptor messages. A descriptor is identified by
+// its path, the field numbers and repeated field indices leading to it from
+// its FileDescriptorGraphql, as protobuf does in SourceCodeInfo.
+const (
+	// FileDescriptorGraphql
+	fileSchemaField         = 2
+	fileScalarsField        = 3
+	fileObjectsField        = 4
+	fileInterfacesField     = 5
+	fileUnionsField         = 6
+	fileEnumsField          = 7
+	fileInputObjectsField   = 8
+	fileDirectivesField     = 9
+	fileTypeExtensionsField = 10
+	fileOperationsField     = 13
+	fileFragmentsField      = 14
+
+	// SchemaDescriptorProto
+	schemaDirectivesField   = 1
+	schemaQueryField        = 6
+	schemaMutationField     = 7
+	schemaSubscriptionField = 8
+
+	// *DefinitionDescriptorProto
+	definitionNameField = 2
+
+	// ScalarTypeDefinitionDescriptorProto
+	scalarDirectivesField = 3
+
+	// ObjectTypeDefinitionDescriptorProto, ObjectTypeExtensionDescriptorProto
+	objectImplementsField = 6
+	objectDirectivesField = 4
+	objectFieldsField     = 5
+
+	// InterfaceTypeDefinitionDescriptorProto
+	interfaceDirectivesField = 3
+	interfaceFieldsField     = 4
+	interfaceImplementsField = 6
+
+	// UnionTypeDefinitionDescriptorProto
+	unionDirectivesField  = 3
+	unionMemberTypesField = 4
+
+	// EnumTypeDefinitionDescriptorProto
+	enumDirectivesField = 3
+	enumValuesField     = 4
+
+	// InputObjectTypeDefinitionDescriptorProto
+	inputObjectDirectivesField = 3
+	inputObjectFieldsField     = 4
+
+	// DirectiveDefinitionDescriptorProto
+	directiveDefinitionArgumentsField = 3
+	directiveDefinitionLocationsField = 4
+
+	// TypeSystemExtensionDescriptorProto
+	typeSystemExtensionSchemaField = 1
+	typeSystemExtensionTypeField   = 2
+
+	// SchemaExtensionDescriptorProto
+	schemaExtensionDirectivesField   = 1
+	schemaExtensionQueryField        = 6
+	schemaExtensionMutationField     = 7
+	schemaExtensionSubscriptionField = 8
+
+	// TypeExtensionDescriptorProto
+	typeExtensionScalarField      = 1
+	typeExtensionObjectField      = 2
+	typeExtensionInterfaceField   = 3
+	typeExtensionUnionField       = 4
+	typeExtensionEnumField        = 5
+	typeExtensionInputObjectField = 6
+
+	// *ExtensionDescriptorProto, other than objects
+	extensionNameField       = 1
+	extensionDirectivesField = 2
+	extensionMembersField    = 3 // fields, member_types or values
+
+	// InterfaceTypeExtensionDescriptorProto
+	interfaceExtensionImplementsField = 5
+
+	// FieldDefinitionDescriptorProto
+	fieldNameField       = 2
+	fieldArgumentsField  = 3
+	fieldTypeField       = 4
+	fieldDirectivesField = 5
+
+	// InputValueDefinitionDescriptorProto
+	inputValueNameField         = 2
+	inputValueTypeField         = 3
+	inputValueDefaultValueField = 4
+	inputValueDirectivesField   = 5
+
+	// EnumValueDefinitionDescription
+	enumValueValueField      = 2
+	enumValueDirectivesField = 3
+
+	// DirectiveDescriptorProto
+	directiveArgumentsField = 2
+
+	// OperationDescriptorProto
+	operationNameField                = 2
+	operationVariableDefinitionsField = 3
+	operationDirectivesField          = 4
+	operationSelectionSetField        = 5
+
+	// VariableDefinitionDescriptorProto
+	variableDefinitionNameField         = 1
+	variableDefinitionTypeField         = 2
+	variableDefinitionDefaultValueField = 3
+	variableDefinitionDirectivesField   = 4
+
+	// FragmentDescriptorProto
+	fragmentNameField          = 1
+	fragmentTypeConditionField = 2
+	fragmentDirectivesField    = 3
+	fragmentSelectionSetField  = 4
+
+	// SelectionSetDescriptorProto
+	selectionSetSelectionsField = 2
+
+	// SelectionDescriptorProto
+	selectionFieldField          = 1
+	selectionFragmentSpreadField = 2
+	selectionInlineFragmentField = 3
+
+	// FieldSelectionDescriptorProto
+	fieldSelectionAliasField        = 1
+	fieldSelectionNameField         = 2
+	fieldSelectionArgumentsField    = 3
+	fieldSelectionDirectivesField   = 4
+	fieldSelectionSelectionSetField = 5
+
+	// FragmentSpreadDescriptorProto
+	fragmentSpreadNameField       = 1
+	fragmentSpreadDirectivesField = 2
+
+	// InlineFragmentDescriptorProto
+	inlineFragmentTypeConditionField = 1
+	inlineFragmentDirectivesField    = 2
+	inlineFragmentSelectionSetField  = 3
+
+	// ArgumentDescriptorProto
+	argumentValueField = 2
+
+	// ValueDescriptorProto
+	valueListValueField   = 9
+	valueObjectValueField = 10
+
+	// ListValueDescriptorProto
+	listValueValuesField = 1
+
+	// ObjectValueDescriptorProto
+	objectValueFieldsField = 1
+
+	// ObjectFieldDescriptorProto
+	objectFieldNameField  = 1
+	objectFieldValueField = 2
+)
+
+// sourceLocation is where the descriptor at path is defined. Comments are
+// only attached to declarations, not to names, types or directive uses.
+type sourceLocation struct {
+	path        []int32
+	loc         *ast.Location
+	declaration bool
+}
+
+// sourceLocations maps descriptor paths to where they are defined.
+type sourceLocations map[string]*sourceLocation
+
+func pathKey(path []int32) string {
+	elems := make([]string, len(path))
+	for i, elem := range path {
+		elems[i] = strconv.Itoa(int(elem))
+	}
+	return strings.Join(elems, ".")
+}
+
+// child returns a new path, path followed by elems.
+func child(path []int32, elems ...int32) []int32 {
+	p := make([]int32, 0, len(path)+len(elems))
+	p = append(p, path...)
+	return append(p, elems...)
+}
+
+func (locs sourceLocations) add(path []int32, loc *ast.Location) {
+	if loc != nil {
+		locs[pathKey(path)] = &sourceLocation{path: path, loc: loc}
+	}
+}
+
+func (locs sourceLocations) addDeclaration(path []int32, loc *ast.Location) {
+	if loc != nil {
+		locs[pathKey(path)] = &sourceLocation{path: path, loc: loc, declaration: true}
+	}
+}
+
+// find returns the location of the descriptor at path, or of its closest
+// ancestor with a known location.
+func (locs sourceLocations) find(path []int32) *ast.Location {
+	for n := len(path); n > 0; n-- {
+		if l, ok := locs[pathKey(path[:n])]; ok {
+			return l.loc
+		}
+	}
+	return nil
+}
+
+// sourceCodeLocations rebuilds the locations of the descriptors of a file
+// from its SourceCodeInfo, for descriptors not built from the source of the
+// file such as those returned by a transformer. Spans outside of the source
+// are ignored.
+func sourceCodeLocations(fd *FileDescriptor) sourceLocations {
+	locs := make(sourceLocations)
+	if fd.doc == nil || fd.doc.Loc == nil {
+		return locs
+	}
+	st := newSourceText(fd)
+	for _, l := range fd.GetSourceCodeInfo().GetLocation() {
+		var startLine, startColumn, endLine, endColumn int32
+		switch len(l.Span) {
+		case 3:
+			startLine, startColumn, endLine, endColumn = l.Span[0], l.Span[1], l.Span[0], l.Span[2]
+		case 4:
+			startLine, startColumn, endLine, endColumn = l.Span[0], l.Span[1], l.Span[2], l.Span[3]
+		default:
+			continue
+		}
+		start, ok := st.offset(int(startLine), int(startColumn))
+		if !ok {
+			continue
+		}
+		end, ok := st.offset(int(endLine), int(endColumn))
+		if !ok {
+			continue
+		}
+		locs.add(l.Path, &ast.Location{Start: start, End: end, Source: fd.doc.Loc.Source})
+	}
+	return locs
+}
+
+// buildLocations records where every descriptor of a file is defined. The
+// descriptors are built in document order, so the n-th definition of a kind
+// in the document is the n-th descriptor of that kind.
+func buildLocations(fd *FileDescriptor) {
+	locs := make(sourceLocations)
+	fd.locations = locs
+
+	counts := make(map[int32]int32)
+	next := func(field int32) []int32 {
+		path := []int32{field, counts[field]}
+		counts[field]++
+		return path
+	}
+
+	for _, node := range fd.doc.Definitions {
+		switch def := node.(type) {
+		case *parser.SchemaDefinition:
+			locs.addSchema([]int32{fileSchemaField}, def.SchemaDefinition, schemaDirectivesField, schemaQueryField)
+		case *ast.ScalarDefinition:
+			path := next(fileScalarsField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addDirectives(child(path, scalarDirectivesField), def.Directives)
+		case *ast.ObjectDefinition:
+			locs.addObject(next(fileObjectsField), def, definitionNameField)
+		case *parser.InterfaceDefinition:
+			path := next(fileInterfacesField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addNamedTypes(child(path, interfaceImplementsField), def.Interfaces)
+			locs.addDirectives(child(path, interfaceDirectivesField), def.Directives)
+			locs.addFields(child(path, interfaceFieldsField), def.Fields)
+		case *ast.UnionDefinition:
+			path := next(fileUnionsField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addDirectives(child(path, unionDirectivesField), def.Directives)
+			locs.addNamedTypes(child(path, unionMemberTypesField), def.Types)
+		case *ast.EnumDefinition:
+			path := next(fileEnumsField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addDirectives(child(path, enumDirectivesField), def.Directives)
+			locs.addEnumValues(child(path, enumValuesField), def.Values)
+		case *ast.InputObjectDefinition:
+			path := next(fileInputObjectsField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addDirectives(child(path, inputObjectDirectivesField), def.Directives)
+			locs.addInputValues(child(path, inputObjectFieldsField), def.Fields)
+		case *parser.DirectiveDefinition:
+			path := next(fileDirectivesField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, definitionNameField), def.Name.Loc)
+			locs.addInputValues(child(path, directiveDefinitionArgumentsField), def.Arguments)
+			for i, locDef := range def.Locations {
+				locs.add(child(path, directiveDefinitionLocationsField, int32(i)), locDef.Loc)
+			}
+		case *parser.TypeExtension:
+			locs.addTypeExtension(next(fileTypeExtensionsField), def)
+		case *ast.OperationDefinition:
+			path := next(fileOperationsField)
+			locs.addDeclaration(path, def.Loc)
+			if def.Name != nil {
+				locs.add(child(path, operationNameField), def.Name.Loc)
+			}
+			for i, variableDef := range def.VariableDefinitions {
+				variablePath := child(path, operationVariableDefinitionsField, int32(i))
+				locs.addDeclaration(variablePath, variableDef.Loc)
+				locs.add(child(variablePath, variableDefinitionNameField), variableDef.Variable.Loc)
+				locs.add(child(variablePath, variableDefinitionTypeField), variableDef.Type.GetLoc())
+				if variableDef.DefaultValue != nil {
+					locs.addValue(child(variablePath, variableDefinitionDefaultValueField), variableDef.DefaultValue)
+				}
+				locs.addDirectives(child(variablePath, variableDefinitionDirectivesField), fd.variableDirectives[variableDef])
+			}
+			locs.addDirectives(child(path, operationDirectivesField), def.Directives)
+			locs.addSelectionSet(child(path, operationSelectionSetField), def.SelectionSet)
+		case *ast.FragmentDefinition:
+			path := next(fileFragmentsField)
+			locs.addDeclaration(path, def.Loc)
+			locs.add(child(path, fragmentNameField), def.Name.Loc)
+			locs.add(child(path, fragmentTypeConditionField), def.TypeCondition.Loc)
+			locs.addDirectives(child(path, fragmentDirectivesField), def.Directives)
+			locs.addSelectionSet(child(path, fragmentSelectionSetField), def.SelectionSet)
+		}
+	}
+}
+
+func (locs sourceLocations) addTypeExtension(path []int32, ext *parser.TypeExtension) {
+	locs.addDeclaration(path, ext.Loc)
+	if def, ok := ext.Definition.(*ast.SchemaDefinition); ok {
+		locs.addSchema(child(path, typeSystemExtensionSchemaField), def, schemaExtensionDirectivesField, schemaExtensionQueryField)
+		return
+	}
+
+	path = child(path, typeSystemExtensionTypeField)
+	switch def := ext.Definition.(type) {
+	case *ast.ScalarDefinition:
+		path = child(path, typeExtensionScalarField)
+		locs.add(path, def.Loc)
+		locs.add(child(path, extensionNameField), def.Name.Loc)
+		locs.addDirectives(child(path, extensionDirectivesField), def.Directives)
+	case *ast.ObjectDefinition:
+		locs.addObject(child(path, typeExtensionObjectField), def, extensionNameField)
+	case *parser.InterfaceDefinition:
+		path = child(path, typeExtensionInterfaceField)
+		locs.add(path, def.Loc)
+		locs.add(child(path, extensionNameField), def.Name.Loc)
+		locs.addNamedTypes(child(path, interfaceExtensionImplementsField), def.Interfaces)
+		locs.addDirectives(child(path, extensionDirectivesField), def.Directives)
+		locs.addFields(child(path, extensionMembersField), def.Fields)
+	case *ast.UnionDefinition:
+		path = child(path, typeExtensionUnionField)
+		locs.add(path, def.Loc)
+		locs.add(child(path, extensionNameField), def.Name.Loc)
+		locs.addDirectives(child(path, extensionDirectivesField), def.Directives)
+		locs.addNamedTypes(child(path, extensionMembersField), def.Types)
+	case *ast.EnumDefinition:
+		path = child(path, typeExtensionEnumField)
+		locs.add(path, def.Loc)
+		locs.add(child(path, extensionNameField), def.Name.Loc)
+		locs.addDirectives(child(path, extensionDirectivesField), def.Directives)
+		locs.addEnumValues(child(path, extensionMembersField), def.Values)
+	case *ast.InputObjectDefinition:
+		path = child(path, typeExtensionInputObjectField)
+		locs.add(path, def.Loc)
+		locs.add(child(path, extensionNameField), def.Name.Loc)
+		locs.addDirectives(child(path, extensionDirectivesField), def.Directives)
+		locs.addInputValues(child(path, extensionMembersField), def.Fields)
+	}
+}
+
+// addSchema records a schema definition or extension, the query, mutation
+// and subscription fields are numbered consecutively from queryField.
+func (locs sourceLocations) addSchema(path []int32, def *ast.SchemaDefinition, directivesField, queryField int32) {
+	locs.addDeclaration(path, def.Loc)
+	locs.addDirectives(child(path, directivesField), def.Directives)
+	for _, operationType := range def.OperationTypes {
+		switch operationType.Operation {
+		case "query":
+			locs.addDeclaration(child(path, queryField), operationType.Loc)
+		case "mutation":
+			locs.addDeclaration(child(path, queryField+1), operationType.Loc)
+		case "subscription":
+			locs.addDeclaration(child(path, queryField+2), operationType.Loc)
+		}
+	}
+}
+
+func (locs sourceLocations) addObject(path []int32, def *ast.ObjectDefinition, nameField int32) {
+	locs.addDeclaration(path, def.Loc)
+	locs.add(child(path, nameField), def.Name.Loc)
+	locs.addNamedTypes(child(path, objectImplementsField), def.Interfaces)
+	locs.addDirectives(child(path, objectDirectivesField), def.Directives)
+	locs.addFields(child(path, objectFieldsField), def.Fields)
+}
+
+func (locs sourceLocations) addFields(path []int32, defs []*ast.FieldDefinition) {
+	for i, def := range defs {
+		fieldPath := child(path, int32(i))
+		locs.addDeclaration(fieldPath, def.Loc)
+		locs.add(child(fieldPath, fieldNameField), def.Name.Loc)
+		locs.addInputValues(child(fieldPath, fieldArgumentsField), def.Arguments)
+		locs.add(child(fieldPath, fieldTypeField), def.Type.GetLoc())
+		locs.addDirectives(child(fieldPath, fieldDirectivesField), def.Directives)
+	}
+}
+
+func (locs sourceLocations) addInputValues(path []int32, defs []*ast.InputValueDefinition) {
+	for i, def := range defs {
+		valuePath := child(path, int32(i))
+		locs.addDeclaration(valuePath, def.Loc)
+		locs.add(child(valuePath, inputValueNameField), def.Name.Loc)
+		locs.add(child(valuePath, inputValueTypeField), def.Type.GetLoc())
+		if def.DefaultValue != nil {
+			locs.addValue(child(valuePath, inputValueDefaultValueField), def.DefaultValue)
+		}
+		locs.addDirectives(child(valuePath, inputValueDirectivesField), def.Directives)
+	}
+}
+
+func (locs sourceLocations) addEnumValues(path []int32, defs []*ast.EnumValueDefinition) {
+	for i, def := range defs {
+		valuePath := child(path, int32(i))
+		locs.addDeclaration(valuePath, def.Loc)
+		locs.add(child(valuePath, enumValueValueField), def.Name.Loc)
+		locs.addDirectives(child(valuePath, enumValueDirectivesField), def.Directives)
+	}
+}
+
+func (locs sourceLocations) addNamedTypes(path []int32, defs []*ast.Named) {
+	for i, def := range defs {
+		locs.add(child(path, int32(i)), def.Loc)
+	}
+}
+
+func (locs sourceLocations) addDirectives(path []int32, defs []*ast.Directive) {
+	for i, def := range defs {
+		directivePath := child(path, int32(i))
+		locs.add(directivePath, def.Loc)
+		locs.addArguments(child(directivePath, directiveArgumentsField), def.Arguments)
+	}
+}
+
+func (locs sourceLocations) addArguments(path []int32, defs []*ast.Argument) {
+	for i, def := range defs {
+		argumentPath := child(path, int32(i))
+		locs.add(argumentPath, def.Loc)
+		locs.addValue(child(argumentPath, argumentValueField), def.Value)
+	}
+}
+
+// addValue records where a value and the values nested in it are written.
+func (locs sourceLocations) addValue(path []int32, def ast.Value) {
+	locs.add(path, def.GetLoc())
+	switch def := def.(type) {
+	case *ast.ListValue:
+		for i, value := range def.Values {
+			locs.addValue(child(path, valueListValueField, listValueValuesField, int32(i)), value)
+		}
+	case *ast.ObjectValue:
+		for i, field := range def.Fields {
+			fieldPath := child(path, valueObjectValueField, objectValueFieldsField, int32(i))
+			locs.add(fieldPath, field.Loc)
+			locs.add(child(fieldPath, objectFieldNameField), field.Name.Loc)
+			locs.addValue(child(fieldPath, objectFieldValueField), field.Value)
+		}
+	}
+}
+
+func (locs sourceLocations) addSelectionSet(path []int32, def *ast.SelectionSet) {
+	if def == nil {
+		return
+	}
+	locs.add(path, def.Loc)
+	for i, selection := range def.Selections {
+		selectionPath := child(path, selectionSetSelectionsField, int32(i))
+		switch selectionDef := selection.(type) {
+		case *ast.Field:
+			fieldPath := child(selectionPath, selectionFieldField)
+			locs.addDeclaration(fieldPath, selectionDef.Loc)
+			if selectionDef.Alias != nil {
+				locs.add(child(fieldPath, fieldSelectionAliasField), selectionDef.Alias.Loc)
+			}
+			locs.add(child(fieldPath, fieldSelectionNameField), selectionDef.Name.Loc)
+			locs.addArguments(child(fieldPath, fieldSelectionArgumentsField), selectionDef.Arguments)
+			locs.addDirectives(child(fieldPath, fieldSelectionDirectivesField), selectionDef.Directives)
+			locs.addSelectionSet(child(fieldPath, fieldSelectionSelectionSetField), selectionDef.SelectionSet)
+		case *ast.FragmentSpread:
+			spreadPath := child(selectionPath, selectionFragmentSpreadField)
+			locs.addDeclaration(spreadPath, selectionDef.Loc)
+			locs.add(child(spreadPath, fragmentSpreadNameField), selectionDef.Name.Loc)
+			locs.addDirectives(child(spreadPath, fragmentSpreadDirectivesField), selectionDef.Directives)
+		case *ast.InlineFragment:
+			fragmentPath := child(selectionPath, selectionInlineFragmentField)
+			locs.addDeclaration(fragmentPath, selectionDef.Loc)
+			if selectionDef.TypeCondition != nil {
+				locs.add(child(fragmentPath, inlineFragmentTypeConditionField), selectionDef.TypeCondition.Loc)
+			}
+			locs.addDirectives(child(fragmentPath, inlineFragmentDirectivesField), selectionDef.Directives)
+			locs.addSelectionSet(child(fragmentPath, inlineFragmentSelectionSetField), selectionDef.SelectionSet)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// prune removes the types which are not reachable, reporting each type
+// removed. Types are reached from the root operation types, the types kept
+// with --prune_keep, the arguments of directive definitions and the
+// operations and fragments of every file, through fields, arguments,
+// interfaces, the implementations of interfaces and union members. The
+// built-in scalars are never removed.
+func (g *Generator) prune() {
+	v := newValidator(g.files, g.schema)
+	implementations := make(map[string][]string)
+	for _, t := range v.typeOrder {
+		for _, ref := range t.interfaces {
+			implementations[ref.name] = append(implementations[ref.name], t.name)
+		}
+	}
+
+	reached := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		t := v.types[name]
+		if t == nil || reached[name] {
+			return
+		}
+		reached[name] = true
+		for _, f := range t.fields {
+			visit(namedTypeName(f.Type))
+			for _, arg := range f.Arguments {
+				visit(namedTypeName(arg.Type))
+			}
+		}
+		for _, f := range t.inputFields {
+			visit(namedTypeName(f.Type))
+		}
+		for _, ref := range t.interfaces {
+			visit(ref.name)
+		}
+		for _, ref := range t.members {
+			visit(ref.name)
+		}
+		for _, impl := range implementations[name] {
+			visit(impl)
+		}
+	}
+
+	for _, root := range v.roots {
+		if root != nil {
+			visit(root.name)
+		}
+	}
+	for _, name := range g.PruneKeep {
+		if v.types[name] == nil {
+			g.Error(fmt.Errorf("--prune_keep: undefined type %q", name))
+		}
+		visit(name)
+	}
+	for _, def := range v.dirOrder {
+		for _, arg := range def.Arguments {
+			visit(namedTypeName(arg.Type))
+		}
+	}
+	for _, fd := range g.files {
+		for _, desc := range fd.Operations {
+			for _, variableDef := range desc.VariableDefinitions {
+				visit(namedTypeName(variableDef.Type))
+			}
+			visitSelectionSet(desc.SelectionSet, visit)
+		}
+		for _, desc := range fd.Fragments {
+			visit(desc.TypeCondition.GetName())
+			visitSelectionSet(desc.SelectionSet, visit)
+		}
+	}
+
+	var diags []*Diagnostic
+	for _, fd := range g.files {
+		if fd.Name == builtinFileName {
+			continue
+		}
+		diags = append(diags, g.removeTypes(fd, func(name string) bool { return !reached[name] })...)
+	}
+	g.reportDiagnostics(diags)
+}
+
+// visitSelectionSet visits the types of the selection sets and type
+// conditions of a selection set.
+func visitSelectionSet(desc *graphqlc.SelectionSetDescriptorProto, visit func(name string)) {
+	if desc == nil {
+		return
+	}
+	visit(desc.Type.GetName())
+	for _, selection := range desc.Selections {
+		switch s := selection.Selection.(type) {
+		case *graphqlc.SelectionDescriptorProto_Field:
+			visitSelectionSet(s.Field.SelectionSet, visit)
+		case *graphqlc.SelectionDescriptorProto_InlineFragment:
+			visit(s.InlineFragment.TypeCondition.GetName())
+			visitSelectionSet(s.InlineFragment.SelectionSet, visit)
+		}
+	}
+}
+
+// removeTypes removes the types of a file for which remove returns true,
+// along with their extensions, returning a note for each type removed.
+func (g *Generator) removeTypes(fd *FileDescriptor, remove func(name string) bool) []*Diagnostic {
+	var diags []*Diagnostic
+	rm := make(removals)
+	keep := func(field int32, i int, name string, kind typeKind) bool {
+		if !remove(name) {
+			return true
+		}
+		path := []int32{field, int32(i)}
+		d := newDiagnostic(fd, fd.locations.find(path), "removed %s %q", kind, name)
+		d.Note = true
+		diags = append(diags, d)
+		rm.add(path)
+		delete(g.typeMap, name)
+		return false
+	}
+
+	scalars := fd.Scalars[:0]
+	for i, desc := range fd.Scalars {
+		if keep(fileScalarsField, i, desc.Name, scalarKind) {
+			scalars = append(scalars, desc)
+		}
+	}
+	fd.Scalars = scalars
+	objects := fd.Objects[:0]
+	for i, desc := range fd.Objects {
+		if keep(fileObjectsField, i, desc.Name, objectKind) {
+			objects = append(objects, desc)
+		}
+	}
+	fd.Objects = objects
+	interfaces := fd.Interfaces[:0]
+	for i, desc := range fd.Interfaces {
+		if keep(fileInterfacesField, i, desc.Name, interfaceKind) {
+			interfaces = append(interfaces, desc)
+		}
+	}
+	fd.Interfaces = interfaces
+	unions := fd.Unions[:0]
+	for i, desc := range fd.Unions {
+		if keep(fileUnionsField, i, desc.Name, unionKind) {
+			unions = append(unions, desc)
+		}
+	}
+	fd.Unions = unions
+	enums := fd.Enums[:0]
+	for i, desc := range fd.Enums {
+		if keep(fileEnumsField, i, desc.Name, enumKind) {
+			enums = append(enums, desc)
+		}
+	}
+	fd.Enums = enums
+	inputObjects := fd.InputObjects[:0]
+	for i, desc := range fd.InputObjects {
+		if keep(fileInputObjectsField, i, desc.Name, inputObjectKind) {
+			inputObjects = append(inputObjects, desc)
+		}
+	}
+	fd.InputObjects = inputObjects
+
+	extensions := fd.TypeExtensions[:0]
+	for i, ext := range fd.TypeExtensions {
+		if name := extendedTypeName(ext); name != "" && remove(name) {
+			rm.add([]int32{fileTypeExtensionsField, int32(i)})
+			continue
+		}
+		extensions = append(extensions, ext)
+	}
+	fd.TypeExtensions = extensions
+
+	rm.apply(fd)
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].Line != diags[j].Line {
+			return diags[i].Line < diags[j].Line
+		}
+		return diags[i].Column < diags[j].Column
+	})
+	return diags
+}
+
+// extendedTypeName returns the name of the type extended by a type system
+// extension, empty for schema extensions.
+func extendedTypeName(ext *graphqlc.TypeSystemExtensionDescriptorProto) string {
+	switch typeExt := ext.GetTypeExtension().GetTypeExtension().(type) {
+	case *graphqlc.TypeExtensionDescriptorProto_ScalarTypeExtension:
+		return typeExt.ScalarTypeExtension.Name
+	case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+		return typeExt.ObjectTypeExtension.Name
+	case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+		return typeExt.InterfaceTypeExtension.Name
+	case *graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension:
+		return typeExt.UnionTypeExtension.Name
+	case *graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions:
+		return typeExt.EnumTypeExtions.Name
+	case *graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension:
+		return typeExt.InputObjectTypeExtension.Name
+	}
+	return ""
+}
+
+// removals are the elements removed from the repeated fields of the
+// descriptors of a file, the indices removed keyed by the path of the
+// repeated field.
+type removals map[string][]int32
+
+// add records the removal of the element at path.
+func (rm removals) add(path []int32) {
+	key := pathKey(path[:len(path)-1])
+	rm[key] = append(rm[key], path[len(path)-1])
+}
+
+// apply updates the SourceCodeInfo of a file once the elements are removed
+// from its descriptors. The locations of the elements removed are dropped and
+// the paths of the elements following them are renumbered.
+func (rm removals) apply(fd *FileDescriptor) {
+	if len(rm) == 0 || fd.SourceCodeInfo == nil {
+		return
+	}
+	for _, indices := range rm {
+		sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	}
+
+	locations := fd.SourceCodeInfo.Location[:0]
+next:
+	for _, l := range fd.SourceCodeInfo.Location {
+		path := child(l.Path)
+		for n := 1; n < len(l.Path); n++ {
+			indices, ok := rm[pathKey(l.Path[:n])]
+			if !ok {
+				continue
+			}
+			index := l.Path[n]
+			removed := sort.Search(len(indices), func(i int) bool { return indices[i] >= index })
+			if removed < len(indices) && indices[removed] == index {
+				continue next
+			}
+			path[n] = index - int32(removed)
+		}
+		l.Path = path
+		locations = append(locations, l)
+	}
+	fd.SourceCodeInfo.Location = locations
+	fd.locations = sourceCodeLocations(fd)
+}
@@ -0,0 +1,101 @@
+package compiler
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		keep []string
+		want []string
+	}{
+		{
+			name: "unreachable types",
+			src:  "type Query { a: A }\ntype A { a: Int }\ntype Unused { a: Int }\nenum E { X }\nscalar Date\nextend type Unused { b: Int }",
+			want: []string{"A", "Query"},
+		},
+		{
+			name: "kept types",
+			src:  "type Query { a: Int }\ntype Unused { e: E }\nenum E { X }\ninput I { a: Int }",
+			keep: []string{"Unused"},
+			want: []string{"E", "Query", "Unused"},
+		},
+		{
+			name: "arguments and input fields",
+			src:  "type Query { a(f: Filter): Int }\ninput Filter { date: Date }\nscalar Date",
+			want: []string{"Date", "Filter", "Query"},
+		},
+		{
+			name: "implementations of interfaces",
+			src:  "type Query { node: Node }\ninterface Node { id: ID }\ntype User implements Node { id: ID }\ntype Other { id: ID }",
+			want: []string{"Node", "Query", "User"},
+		},
+		{
+			name: "union members",
+			src:  "type Query { result: Result }\nunion Result = A | B\ntype A { a: Int }\ntype B { b: Int }",
+			want: []string{"A", "B", "Query", "Result"},
+		},
+		{
+			name: "directive arguments",
+			src:  "directive @cost(level: Level) on FIELD_DEFINITION\nenum Level { LOW HIGH }\ntype Query { a: Int }",
+			want: []string{"Level", "Query"},
+		},
+		{
+			name: "operations and fragments",
+			src:  "type Query { node: Node }\ninterface Node { id: ID }\ntype A { id: ID }\ntype B { id: ID }\n{ node { ... on A { id } } }\nfragment F on B { id }",
+			want: []string{"A", "B", "Node", "Query"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, map[string]string{"schema.graphql": tt.src})
+			g.PruneKeep = tt.keep
+			g.prune()
+
+			fd, ok := findFile(g.files, "schema.graphql")
+			if !ok {
+				t.Fatal("schema.graphql: file not found")
+			}
+			var got []string
+			for _, desc := range fd.Scalars {
+				got = append(got, desc.Name)
+			}
+			for _, desc := range fd.Objects {
+				got = append(got, desc.Name)
+			}
+			for _, desc := range fd.Interfaces {
+				got = append(got, desc.Name)
+			}
+			for _, desc := range fd.Unions {
+				got = append(got, desc.Name)
+			}
+			for _, desc := range fd.Enums {
+				got = append(got, desc.Name)
+			}
+			for _, desc := range fd.InputObjects {
+				got = append(got, desc.Name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got types %v, want %v", got, tt.want)
+			}
+			for _, ext := range fd.TypeExtensions {
+				if name := extendedTypeName(ext); !containsString(tt.want, name) {
+					t.Errorf("extension of %s was not removed", name)
+				}
+			}
+		})
+	}
+}
+
+func TestPruneArguments(t *testing.T) {
+	g := New()
+	g.CommandLineArguments([]string{"--prune", "--prune_keep=A,B", "--prune_keep=C"})
+	if want := []string{"A", "B", "C"}; !g.Prune || !reflect.DeepEqual(g.PruneKeep, want) {
+		t.Errorf("got prune %t keeping %v, want prune true keeping %v", g.Prune, g.PruneKeep, want)
+	}
+}
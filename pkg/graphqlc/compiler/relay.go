@@ -0,0 +1,209 @@
+package compiler
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// Relay connections, see https://relay.dev/graphql/connections.htm. A list
+// field marked with the @connection directive of graphqlc/directives.graphql
+//
+//	friends: [User!] @connection
+//
+// is expanded into
+//
+//	friends(first: Int, after: String, last: Int, before: String): UserConnection
+//
+// with the connection, edge and page info objects
+//
+//	type UserConnection { edges: [UserEdge] pageInfo: PageInfo! }
+//	type UserEdge { node: User! cursor: String! }
+//	type PageInfo {
+//	  hasPreviousPage: Boolean!
+//	  hasNextPage: Boolean!
+//	  startCursor: String
+//	  endCursor: String
+//	}
+//
+// added to the file of the field. Objects already defined are not
+// synthesized again and arguments already defined are kept.
+const connectionDirectiveName = "connection"
+const pageInfoTypeName = "PageInfo"
+
+// The pagination arguments of a connection field.
+var connectionArguments = []struct {
+	name, typ string
+}{
+	{"first", "Int"},
+	{"after", "String"},
+	{"last", "Int"},
+	{"before", "String"},
+}
+
+// connectionExpander expands the @connection fields of a file.
+type connectionExpander struct {
+	tm    typeMap
+	fd    *FileDescriptor
+	diags []*Diagnostic
+}
+
+// expandConnections expands every field marked with @connection, reporting
+// every field which is not a list and exiting the program if there are any.
+func (g *Generator) expandConnections() {
+	var diags []*Diagnostic
+	for _, fd := range g.files {
+		x := &connectionExpander{tm: g.typeMap, fd: fd}
+		for i, n := 0, len(fd.Objects); i < n; i++ {
+			desc := fd.Objects[i]
+			x.fields(desc.Name, []int32{fileObjectsField, int32(i), objectFieldsField}, desc.Fields)
+		}
+		for i, desc := range fd.Interfaces {
+			x.fields(desc.Name, []int32{fileInterfacesField, int32(i), interfaceFieldsField}, desc.Fields)
+		}
+		for i, ext := range fd.TypeExtensions {
+			path := []int32{fileTypeExtensionsField, int32(i), typeSystemExtensionTypeField}
+			switch typeExt := ext.GetTypeExtension().GetTypeExtension().(type) {
+			case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+				desc := typeExt.ObjectTypeExtension
+				x.fields(desc.Name, child(path, typeExtensionObjectField, objectFieldsField), desc.Fields)
+			case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+				desc := typeExt.InterfaceTypeExtension
+				x.fields(desc.Name, child(path, typeExtensionInterfaceField, extensionMembersField), desc.Fields)
+			}
+		}
+		diags = append(diags, x.diags...)
+	}
+	g.reportDiagnostics(diags)
+}
+
+func (x *connectionExpander) fields(typeName string, path []int32, descs []*graphqlc.FieldDefinitionDescriptorProto) {
+	for i, desc := range descs {
+		if findDirective(desc.Directives, connectionDirectiveName) != nil {
+			x.expand(typeName, child(path, int32(i)), desc)
+		}
+	}
+}
+
+// expand expands the connection field desc of the type named typeName.
+func (x *connectionExpander) expand(typeName string, path []int32, desc *graphqlc.FieldDefinitionDescriptorProto) {
+	loc := x.fd.locations.find(path)
+	typ, nonNull := desc.Type, false
+	if inner, ok := nonNullOf(typ); ok {
+		typ, nonNull = inner, true
+	}
+	list := typ.GetListType()
+	if list == nil {
+		x.diags = append(x.diags, newDiagnostic(x.fd, loc, "@connection may only be used on list fields, %s.%s is %s", typeName, desc.Name, typeString(desc.Type)))
+		return
+	}
+	node := list.Type
+	if inner, ok := nonNullOf(node); ok {
+		node = inner
+	}
+	if node.GetListType() != nil {
+		x.diags = append(x.diags, newDiagnostic(x.fd, loc, "the items of connection field %s.%s must not be lists", typeName, desc.Name))
+		return
+	}
+
+	nodeName := namedTypeName(list.Type)
+	connectionName, edgeName := nodeName+"Connection", nodeName+"Edge"
+	x.synthesize(path, pageInfoTypeName,
+		fieldDescriptor("hasPreviousPage", namedTypeDescriptor("Boolean", true)),
+		fieldDescriptor("hasNextPage", namedTypeDescriptor("Boolean", true)),
+		fieldDescriptor("startCursor", namedTypeDescriptor("String", false)),
+		fieldDescriptor("endCursor", namedTypeDescriptor("String", false)),
+	)
+	x.synthesize(path, edgeName,
+		fieldDescriptor("node", proto.Clone(list.Type).(*graphqlc.TypeDescriptorProto)),
+		fieldDescriptor("cursor", namedTypeDescriptor("String", true)),
+	)
+	x.synthesize(path, connectionName,
+		fieldDescriptor("edges", &graphqlc.TypeDescriptorProto{
+			Type: &graphqlc.TypeDescriptorProto_ListType{
+				ListType: &graphqlc.ListTypeDescriptorProto{Type: namedTypeDescriptor(edgeName, false)},
+			},
+		}),
+		fieldDescriptor("pageInfo", namedTypeDescriptor(pageInfoTypeName, true)),
+	)
+
+	desc.Type = namedTypeDescriptor(connectionName, nonNull)
+	for _, arg := range connectionArguments {
+		if findInputValue(desc.Arguments, arg.name) != nil {
+			continue
+		}
+		x.fd.locations.add(child(path, fieldArgumentsField, int32(len(desc.Arguments))), loc)
+		desc.Arguments = append(desc.Arguments, &graphqlc.InputValueDefinitionDescriptorProto{
+			Name:        arg.name,
+			Type:        namedTypeDescriptor(arg.typ, false),
+			Directives:  []*graphqlc.DirectiveDescriptorProto{},
+			Synthesized: true,
+		})
+	}
+}
+
+// synthesize adds an object to the file unless a type of the same name is
+// defined, it is located at the field at path.
+func (x *connectionExpander) synthesize(path []int32, name string, fields ...*graphqlc.FieldDefinitionDescriptorProto) {
+	if _, ok := x.tm[name]; ok {
+		return
+	}
+	loc := x.fd.locations.find(path)
+	objectPath := []int32{fileObjectsField, int32(len(x.fd.Objects))}
+	x.fd.locations.add(objectPath, loc)
+	for i := range fields {
+		x.fd.locations.add(child(objectPath, objectFieldsField, int32(i)), loc)
+	}
+
+	desc := &graphqlc.ObjectTypeDefinitionDescriptorProto{
+		Name:        name,
+		Implements:  []*graphqlc.NamedTypeDescriptorProto{},
+		Directives:  []*graphqlc.DirectiveDescriptorProto{},
+		Fields:      fields,
+		Synthesized: true,
+	}
+	x.fd.Objects = append(x.fd.Objects, desc)
+	x.tm[name] = &definition{desc: desc, file: x.fd, loc: loc}
+}
+
+func fieldDescriptor(name string, typ *graphqlc.TypeDescriptorProto) *graphqlc.FieldDefinitionDescriptorProto {
+	return &graphqlc.FieldDefinitionDescriptorProto{
+		Name:       name,
+		Arguments:  []*graphqlc.InputValueDefinitionDescriptorProto{},
+		Type:       typ,
+		Directives: []*graphqlc.DirectiveDescriptorProto{},
+	}
+}
+
+// namedTypeDescriptor returns the type named name, non-null if nonNull is
+// set.
+func namedTypeDescriptor(name string, nonNull bool) *graphqlc.TypeDescriptorProto {
+	ref := &graphqlc.NamedTypeDescriptorProto{Name: name}
+	if nonNull {
+		return &graphqlc.TypeDescriptorProto{
+			Type: &graphqlc.TypeDescriptorProto_NonNullType{
+				NonNullType: &graphqlc.NonNullTypeDescriptorProto{
+					Type: &graphqlc.NonNullTypeDescriptorProto_NamedType{NamedType: ref},
+				},
+			},
+		}
+	}
+	return &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_NamedType{NamedType: ref}}
+}
+
+func findDirective(descs []*graphqlc.DirectiveDescriptorProto, name string) *graphqlc.DirectiveDescriptorProto {
+	for _, desc := range descs {
+		if desc.Name == name {
+			return desc
+		}
+	}
+	return nil
+}
+
+func findInputValue(descs []*graphqlc.InputValueDefinitionDescriptorProto, name string) *graphqlc.InputValueDefinitionDescriptorProto {
+	for _, desc := range descs {
+		if desc.Name == name {
+			return desc
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+func TestConnectionDirective(t *testing.T) {
+	g := buildTestGenerator(t, map[string]string{
+		"query.graphql": "type Query { users: [User!] @connection }\ntype User { id: ID! }",
+	})
+	g.ValidateTypes()
+	g.buildRequest()
+	r := graphqlc.NewResolver(g.Request)
+
+	connection := r.Directive("connection")
+	if connection == nil {
+		t.Fatal("@connection is not defined")
+	}
+	if directives := r.File(directivesFileName).GetDirectives(); len(directives) != 1 || directives[0] != connection {
+		t.Errorf("got %s directives %v, want @connection", directivesFileName, directives)
+	}
+	if connection.Builtin || !connection.Synthesized {
+		t.Errorf("got @connection builtin %t and synthesized %t, want builtin false and synthesized true", connection.Builtin, connection.Synthesized)
+	}
+	for _, name := range []string{"skip", "include", "deprecated", "specifiedBy"} {
+		if def := r.Directive(name); !def.GetBuiltin() || def.GetSynthesized() {
+			t.Errorf("got @%s builtin %t and synthesized %t, want builtin true and synthesized false", name, def.GetBuiltin(), def.GetSynthesized())
+		}
+	}
+
+	field := r.Object("Query").Fields[0]
+	if file := field.Directives[0].File; file != directivesFileName {
+		t.Errorf("got @connection used from %q, want %q", file, directivesFileName)
+	}
+}
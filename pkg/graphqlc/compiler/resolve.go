@@ -0,0 +1,159 @@
+package compiler
+
+import (
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// typeResolver sets the kind and defining file of named type references.
+// Names which are not defined are left unresolved, validation reports them.
+type typeResolver struct {
+	tm typeMap
+}
+
+// resolveNamedTypes resolves every named type referenced by the
+// definitions of a file.
+func resolveNamedTypes(tm typeMap, fd *FileDescriptor) {
+	r := typeResolver{tm: tm}
+	r.schema(fd.Schema)
+	for _, ext := range fd.TypeExtensions {
+		if schemaExt := ext.GetSchemaExtension(); schemaExt != nil {
+			r.namedType(schemaExt.Query)
+			r.namedType(schemaExt.Mutation)
+			r.namedType(schemaExt.Subscription)
+		}
+		r.typeExtension(ext.GetTypeExtension())
+	}
+	for _, desc := range fd.Directives {
+		r.inputValues(desc.Arguments)
+	}
+	for _, desc := range fd.Objects {
+		r.namedTypes(desc.Implements)
+		r.fields(desc.Fields)
+	}
+	for _, desc := range fd.Interfaces {
+		r.namedTypes(desc.Implements)
+		r.fields(desc.Fields)
+	}
+	for _, desc := range fd.Unions {
+		r.namedTypes(desc.MemberTypes)
+	}
+	for _, desc := range fd.InputObjects {
+		r.inputValues(desc.Fields)
+	}
+	for _, desc := range fd.Operations {
+		for _, variableDef := range desc.VariableDefinitions {
+			r.typ(variableDef.Type)
+		}
+		r.selectionSet(desc.SelectionSet)
+	}
+	for _, desc := range fd.Fragments {
+		r.namedType(desc.TypeCondition)
+		r.selectionSet(desc.SelectionSet)
+	}
+}
+
+func (r typeResolver) schema(desc *graphqlc.SchemaDescriptorProto) {
+	if desc == nil {
+		return
+	}
+	r.namedType(desc.Query)
+	r.namedType(desc.Mutation)
+	r.namedType(desc.Subscription)
+}
+
+func (r typeResolver) namedType(ref *graphqlc.NamedTypeDescriptorProto) {
+	if ref == nil {
+		return
+	}
+	// A reference resolved before its type was removed, by a transformer or
+	// a variant, is unresolved again
+	ref.Kind, ref.File = graphqlc.NamedTypeDescriptorProto_UNRESOLVED, ""
+	def, ok := r.tm[ref.Name]
+	if !ok {
+		return
+	}
+	switch def.desc.(type) {
+	case *graphqlc.ScalarTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_SCALAR
+	case *graphqlc.ObjectTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_OBJECT
+	case *graphqlc.InterfaceTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_INTERFACE
+	case *graphqlc.UnionTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_UNION
+	case *graphqlc.EnumTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_ENUM
+	case *graphqlc.InputObjectTypeDefinitionDescriptorProto:
+		ref.Kind = graphqlc.NamedTypeDescriptorProto_INPUT_OBJECT
+	default:
+		return
+	}
+	ref.File = def.file.Name
+}
+
+func (r typeResolver) namedTypes(refs []*graphqlc.NamedTypeDescriptorProto) {
+	for _, ref := range refs {
+		r.namedType(ref)
+	}
+}
+
+func (r typeResolver) typ(typ *graphqlc.TypeDescriptorProto) {
+	switch t := typ.GetType().(type) {
+	case *graphqlc.TypeDescriptorProto_NamedType:
+		r.namedType(t.NamedType)
+	case *graphqlc.TypeDescriptorProto_ListType:
+		r.typ(t.ListType.Type)
+	case *graphqlc.TypeDescriptorProto_NonNullType:
+		switch t := t.NonNullType.Type.(type) {
+		case *graphqlc.NonNullTypeDescriptorProto_NamedType:
+			r.namedType(t.NamedType)
+		case *graphqlc.NonNullTypeDescriptorProto_ListType:
+			r.typ(t.ListType.Type)
+		}
+	}
+}
+
+func (r typeResolver) fields(defs []*graphqlc.FieldDefinitionDescriptorProto) {
+	for _, def := range defs {
+		r.inputValues(def.Arguments)
+		r.typ(def.Type)
+	}
+}
+
+func (r typeResolver) inputValues(defs []*graphqlc.InputValueDefinitionDescriptorProto) {
+	for _, def := range defs {
+		r.typ(def.Type)
+	}
+}
+
+func (r typeResolver) typeExtension(ext *graphqlc.TypeExtensionDescriptorProto) {
+	switch ext := ext.GetTypeExtension().(type) {
+	case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+		r.namedTypes(ext.ObjectTypeExtension.Implements)
+		r.fields(ext.ObjectTypeExtension.Fields)
+	case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+		r.namedTypes(ext.InterfaceTypeExtension.Implements)
+		r.fields(ext.InterfaceTypeExtension.Fields)
+	case *graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension:
+		r.namedTypes(ext.UnionTypeExtension.MemberTypes)
+	case *graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension:
+		r.inputValues(ext.InputObjectTypeExtension.Fields)
+	}
+}
+
+func (r typeResolver) selectionSet(desc *graphqlc.SelectionSetDescriptorProto) {
+	if desc == nil {
+		return
+	}
+	r.namedType(desc.Type)
+	for _, selection := range desc.Selections {
+		switch s := selection.Selection.(type) {
+		case *graphqlc.SelectionDescriptorProto_Field:
+			r.namedType(s.Field.ParentType)
+			r.selectionSet(s.Field.SelectionSet)
+		case *graphqlc.SelectionDescriptorProto_InlineFragment:
+			r.namedType(s.InlineFragment.TypeCondition)
+			r.selectionSet(s.InlineFragment.SelectionSet)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package compiler
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// comment is a single # comment, text excludes the # and line terminator.
+type comment struct {
+	start, end int
+	line       int
+	text       string
+}
+
+// sourceText is the text of a file with the positions of its comments and
+// of every byte which is part of a token.
+type sourceText struct {
+	body       []byte
+	lineStarts []int
+	comments   []*comment
+	token      []bool // token[i] is true if body[i] is part of a token
+}
+
+func newSourceText(fd *FileDescriptor) *sourceText {
+	body := fd.body
+	st := &sourceText{
+		body:       body,
+		lineStarts: []int{0},
+		token:      make([]bool, len(body)),
+	}
+	for i := 0; i < len(body); i++ {
+		if c := body[i]; c == '\r' || c == '\n' {
+			if c == '\r' && i+1 < len(body) && body[i+1] == '\n' {
+				i++
+			}
+			st.lineStarts = append(st.lineStarts, i+1)
+		}
+	}
+	for _, c := range fd.comments {
+		st.comments = append(st.comments, &comment{
+			start: c.Start,
+			end:   c.End,
+			line:  st.line(c.Start),
+			text:  c.Text,
+		})
+	}
+	for _, t := range fd.tokens {
+		for i := t.Start; i < t.End; i++ {
+			st.token[i] = true
+		}
+	}
+	return st
+}
+
+// position returns the zero-based line and byte column of offset.
+func (st *sourceText) position(offset int) (line, column int) {
+	line = sort.Search(len(st.lineStarts), func(i int) bool { return st.lineStarts[i] > offset }) - 1
+	return line, offset - st.lineStarts[line]
+}
+
+// offset returns the offset of a zero-based line and byte column, false if
+// the file has no such line.
+func (st *sourceText) offset(line, column int) (int, bool) {
+	if line < 0 || line >= len(st.lineStarts) || column < 0 {
+		return 0, false
+	}
+	offset := st.lineStarts[line] + column
+	if offset > len(st.body) {
+		return 0, false
+	}
+	return offset, true
+}
+
+func (st *sourceText) line(offset int) int {
+	line, _ := st.position(offset)
+	return line
+}
+
+// span returns the protobuf style span of the bytes from start to end.
+func (st *sourceText) span(start, end int) []int32 {
+	startLine, startColumn := st.position(start)
+	endLine, endColumn := st.position(end)
+	if startLine == endLine {
+		return []int32{int32(startLine), int32(startColumn), int32(endColumn)}
+	}
+	return []int32{int32(startLine), int32(startColumn), int32(endLine), int32(endColumn)}
+}
+
+// prevTokenEnd returns the position after the last token before offset,
+// or -1 if there is none.
+func (st *sourceText) prevTokenEnd(offset int) int {
+	for i := offset - 1; i >= 0; i-- {
+		if st.token[i] {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// nextTokenStart returns the position of the first token at or after
+// offset, or the length of the body if there is none.
+func (st *sourceText) nextTokenStart(offset int) int {
+	for i := offset; i < len(st.body); i++ {
+		if st.token[i] {
+			return i
+		}
+	}
+	return len(st.body)
+}
+
+// commentBlocks groups the comments between start and end into blocks of
+// comments on consecutive lines.
+func (st *sourceText) commentBlocks(start, end int) [][]*comment {
+	var blocks [][]*comment
+	for _, c := range st.comments {
+		if c.start < start || c.start >= end {
+			continue
+		}
+		if n := len(blocks); n > 0 {
+			last := blocks[n-1][len(blocks[n-1])-1]
+			if last.line+1 == c.line {
+				blocks[n-1] = append(blocks[n-1], c)
+				continue
+			}
+		}
+		blocks = append(blocks, []*comment{c})
+	}
+	return blocks
+}
+
+func commentText(block []*comment) string {
+	var b strings.Builder
+	for _, c := range block {
+		b.WriteString(c.text)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// buildSourceCodeInfo builds the SourceCodeInfo of a file from the locations
+// of its descriptors and the comments in its source. Introspection results
+// have none, their descriptors are not parsed from the file.
+func buildSourceCodeInfo(fd *FileDescriptor) *graphqlc.SourceCodeInfo {
+	if fd.introspected {
+		return nil
+	}
+	st := newSourceText(fd)
+
+	locs := make([]*sourceLocation, 0, len(fd.locations))
+	for _, l := range fd.locations {
+		locs = append(locs, l)
+	}
+	// Document order, enclosing locations first
+	sort.Slice(locs, func(i, j int) bool {
+		a, b := locs[i], locs[j]
+		if a.loc.Start != b.loc.Start {
+			return a.loc.Start < b.loc.Start
+		}
+		if a.loc.End != b.loc.End {
+			return a.loc.End > b.loc.End
+		}
+		return pathKey(a.path) < pathKey(b.path)
+	})
+
+	claimed := make(map[*comment]bool) // Comments used as trailing comments
+	info := &graphqlc.SourceCodeInfo{}
+	for _, l := range locs {
+		location := &graphqlc.SourceCodeInfo_Location{
+			Path: l.path,
+			Span: st.span(l.loc.Start, l.loc.End),
+		}
+		if l.declaration {
+			attachComments(st, location, l.loc.Start, l.loc.End, claimed)
+		}
+		info.Location = append(info.Location, location)
+	}
+	return info
+}
+
+// attachComments sets the leading, trailing and detached comments of the
+// declaration from start to end.
+func attachComments(st *sourceText, location *graphqlc.SourceCodeInfo_Location, start, end int, claimed map[*comment]bool) {
+	prevEnd := st.prevTokenEnd(start)
+	blocks := st.commentBlocks(prevEnd, start)
+	// A comment on the line of the previous token belongs to that token
+	if len(blocks) > 0 && prevEnd >= 0 && blocks[0][0].line == st.line(prevEnd-1) {
+		blocks[0] = blocks[0][1:]
+		if len(blocks[0]) == 0 {
+			blocks = blocks[1:]
+		}
+	}
+	for i, block := range blocks {
+		if claimed[block[0]] {
+			continue
+		}
+		if i == len(blocks)-1 && block[len(block)-1].line+1 == st.line(start) {
+			location.LeadingComments = commentText(block)
+			continue
+		}
+		location.LeadingDetachedComments = append(location.LeadingDetachedComments, commentText(block))
+	}
+
+	nextStart := st.nextTokenStart(end)
+	blocks = st.commentBlocks(end, nextStart)
+	if len(blocks) == 0 {
+		return
+	}
+	block := blocks[0]
+	endLine := st.line(end - 1)
+	switch {
+	case block[0].line == endLine:
+		// A comment on the line the declaration ends on
+		block = block[:1]
+	case block[0].line == endLine+1 && (nextStart == len(st.body) || st.line(nextStart) > block[len(block)-1].line+1):
+		// A comment on the following lines, separated from the next token
+		// by a blank line
+	default:
+		return
+	}
+	location.TrailingComments = commentText(block)
+	for _, c := range block {
+		claimed[c] = true
+	}
+}
@@ -0,0 +1,169 @@
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// TransformerMeta is a transformer plugin, graphqlc-xform-NAME, given on the
+// command line as --NAME_xform or --NAME_xform=PARAMS.
+type TransformerMeta struct {
+	Name, Params string
+}
+
+// parseTransformerArgument parses a --NAME_xform argument, without the
+// leading dashes, false if arg is not one.
+func parseTransformerArgument(arg string) (*TransformerMeta, bool) {
+	name, params := arg, ""
+	if i := strings.Index(arg, "="); i >= 0 {
+		name, params = arg[:i], arg[i+1:]
+	}
+	if !strings.HasSuffix(name, "_xform") || name == "_xform" {
+		return nil, false
+	}
+	return &TransformerMeta{Name: strings.TrimSuffix(name, "_xform"), Params: params}, true
+}
+
+// runTransformers runs the transformer plugins in command line order, each
+// given the files returned by the one before. The files returned are
+// validated again before the next plugin is run.
+func (g *Generator) runTransformers() {
+	for _, t := range g.Transformers {
+		resp, err := g.runTransformer(t)
+		if err != nil {
+			g.Error(err)
+		}
+		if resp.Error != "" {
+			g.Error(errors.New(resp.Error), "graphqlc-xform-"+t.Name)
+		}
+		schema := resp.Schema
+		if schema == nil {
+			schema = g.schema
+		}
+		g.replaceFiles(resp.GraphqlFile, schema)
+	}
+}
+
+func (g *Generator) runTransformer(t *TransformerMeta) (*graphqlc.TransformerResponse, error) {
+	var stdout, stderr bytes.Buffer
+	g.Request.Parameter = t.Params
+	data, err := proto.Marshal(g.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("graphqlc-xform-" + t.Name)
+	cmd.Env = os.Environ()
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stderr = &stderr
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("graphqlc-xform-%s: %s %s", t.Name, err, stderr.String())
+	}
+
+	resp := new(graphqlc.TransformerResponse)
+	if err := proto.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return nil, fmt.Errorf("graphqlc-xform-%s: %s", t.Name, err)
+	}
+	return resp, nil
+}
+
+// replaceFiles replaces the files being compiled with transformed files,
+// validates them and rebuilds the request given to plugins. A file keeps
+// the source of the file of the same name, errors are reported at the spans
+// given by its SourceCodeInfo.
+func (g *Generator) replaceFiles(descs []*graphqlc.FileDescriptorGraphql, schema *graphqlc.SchemaDescriptorProto) {
+	files := make([]*FileDescriptor, 0, len(descs))
+	for _, desc := range descs {
+		fd, ok := findFile(g.files, desc.Name)
+		if !ok {
+			fd = &FileDescriptor{}
+		}
+		fd.FileDescriptorGraphql = desc
+		fd.locations = sourceCodeLocations(fd)
+		files = append(files, fd)
+	}
+	for i, fd := range g.genFiles {
+		genFile, ok := findFile(files, fd.Name)
+		if !ok {
+			g.Error(fmt.Errorf("%s: file to generate removed by transformation", fd.Name))
+		}
+		g.genFiles[i] = genFile
+	}
+	g.files = files
+	g.rebuildTypes(schema)
+
+	g.Request.Schema = g.schema
+	g.Request.GraphqlFile = g.Request.GraphqlFile[:0]
+	for _, fd := range g.files {
+		g.Request.GraphqlFile = append(g.Request.GraphqlFile, fd.FileDescriptorGraphql)
+	}
+}
+
+// rebuildTypes rebuilds the type map from the descriptors of every file and
+// validates the type system and the operations again. The schema of the
+// files is schema unless a file defines it.
+func (g *Generator) rebuildTypes(schema *graphqlc.SchemaDescriptorProto) {
+	g.typeMap = make(typeMap)
+	var diags []*Diagnostic
+	for _, fd := range g.files {
+		diags = append(diags, buildDescriptorTypeMap(g.typeMap, fd)...)
+	}
+	g.reportDiagnostics(diags)
+
+	g.schema = schema
+	for _, fd := range g.files {
+		if fd.Schema != nil {
+			g.schema = fd.Schema
+		}
+		resolveNamedTypes(g.typeMap, fd)
+	}
+	typeResolver{tm: g.typeMap}.schema(g.schema)
+
+	g.ValidateTypes()
+	g.ValidateOperations()
+}
+
+// buildDescriptorTypeMap adds the definitions of a file to the type map from
+// its descriptors rather than its source.
+func buildDescriptorTypeMap(tm typeMap, fd *FileDescriptor) []*Diagnostic {
+	var diags []*Diagnostic
+	add := func(key string, desc interface{}, path ...int32) {
+		diags = append(diags, tm.add(key, &definition{desc: desc, file: fd, loc: fd.locations.find(path)})...)
+	}
+	if fd.Schema != nil {
+		add(schemaKey, fd.Schema, fileSchemaField)
+	}
+	for i, desc := range fd.Scalars {
+		add(desc.Name, desc, fileScalarsField, int32(i))
+	}
+	for i, desc := range fd.Objects {
+		add(desc.Name, desc, fileObjectsField, int32(i))
+	}
+	for i, desc := range fd.Interfaces {
+		add(desc.Name, desc, fileInterfacesField, int32(i))
+	}
+	for i, desc := range fd.Unions {
+		add(desc.Name, desc, fileUnionsField, int32(i))
+	}
+	for i, desc := range fd.Enums {
+		add(desc.Name, desc, fileEnumsField, int32(i))
+	}
+	for i, desc := range fd.InputObjects {
+		add(desc.Name, desc, fileInputObjectsField, int32(i))
+	}
+	for i, desc := range fd.Directives {
+		add(directiveKeyPrefix+desc.Name, desc, fileDirectivesField, int32(i))
+	}
+	for i, desc := range fd.Fragments {
+		add(fragmentKeyPrefix+desc.Name, desc, fileFragmentsField, int32(i))
+	}
+	return diags
+}
@@ -0,0 +1,66 @@
+package compiler
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// TestReplaceFilesValidatesOperations runs itself again to replace the
+// files, an invalid operation exits the program.
+func TestReplaceFilesValidatesOperations(t *testing.T) {
+	if os.Getenv("GRAPHQLC_TEST_REPLACE_FILES") == "1" {
+		g := buildTestGenerator(t, map[string]string{
+			"query.graphql": "type Query { a: Int b: Int }\n{ a b }",
+		})
+		g.buildRequest()
+		// A transformer removing Query.b
+		var descs []*graphqlc.FileDescriptorGraphql
+		for _, fd := range g.files {
+			desc := proto.Clone(fd.FileDescriptorGraphql).(*graphqlc.FileDescriptorGraphql)
+			for _, obj := range desc.Objects {
+				if obj.Name == "Query" {
+					obj.Fields = obj.Fields[:1]
+				}
+			}
+			descs = append(descs, desc)
+		}
+		g.replaceFiles(descs, g.schema)
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestReplaceFilesValidatesOperations$")
+	cmd.Env = append(os.Environ(), "GRAPHQLC_TEST_REPLACE_FILES=1")
+	out, err := cmd.CombinedOutput()
+	if _, ok := err.(*exec.ExitError); !ok {
+		t.Fatalf("got error %v, want exit status 1, output:\n%s", err, out)
+	}
+	want := `query.graphql:2:5: Cannot query field "b" on type "Query"`
+	if !strings.Contains(string(out), want) {
+		t.Errorf("got output\n%s\nwant %s", out, want)
+	}
+}
+
+// TestResolveRemovedType checks a reference to a type which is no longer
+// defined is unresolved rather than left naming the type it was resolved to.
+func TestResolveRemovedType(t *testing.T) {
+	g := buildTestGenerator(t, map[string]string{
+		"schema.graphql": "type Query { a: A }\ntype A { b: Int }",
+	})
+	ref := g.typeMap["Query"].desc.(*graphqlc.ObjectTypeDefinitionDescriptorProto).Fields[0].Type.GetNamedType()
+	if ref.Kind != graphqlc.NamedTypeDescriptorProto_OBJECT || ref.File != "schema.graphql" {
+		t.Fatalf("got %v %q, want OBJECT %q", ref.Kind, ref.File, "schema.graphql")
+	}
+
+	delete(g.typeMap, "A")
+	for _, fd := range g.files {
+		resolveNamedTypes(g.typeMap, fd)
+	}
+	if ref.Kind != graphqlc.NamedTypeDescriptorProto_UNRESOLVED || ref.File != "" {
+		t.Errorf("got %v %q, want UNRESOLVED %q", ref.Kind, ref.File, "")
+	}
+}
@@ -0,0 +1,58 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// The schema definition is kept in the type map under its own key, directive
+// and fragment definitions are kept under their name prefixed with
+// directiveKeyPrefix and fragmentKeyPrefix.
+const schemaKey = "schema"
+const directiveKeyPrefix = "@"
+const fragmentKeyPrefix = "..."
+
+// definition is a top level definition and the file it is defined in.
+type definition struct {
+	desc interface{}
+	file *FileDescriptor
+	loc  *ast.Location
+}
+
+// typeMap maps names to the definitions of every file being compiled.
+type typeMap map[string]*definition
+
+// add adds a definition, it is an error to define a name more than once.
+func (tm typeMap) add(key string, def *definition) []*Diagnostic {
+	if prev, ok := tm[key]; ok {
+		note := newDiagnostic(prev.file, prev.loc, "previous definition of %q", key)
+		note.Note = true
+		return []*Diagnostic{newDiagnostic(def.file, def.loc, "%q is already defined", key), note}
+	}
+	tm[key] = def
+	return nil
+}
+
+func (tm typeMap) descriptor(key string) interface{} {
+	if def, ok := tm[key]; ok {
+		return def.desc
+	}
+	return nil
+}
+
+func (tm typeMap) object(name string) (*graphqlc.ObjectTypeDefinitionDescriptorProto, bool) {
+	desc, ok := tm.descriptor(name).(*graphqlc.ObjectTypeDefinitionDescriptorProto)
+	return desc, ok
+}
+
+// position formats a location in a file as file:line:column.
+func position(fd *FileDescriptor, loc *ast.Location) string {
+	if loc == nil || loc.Source == nil || fd.introspected {
+		return fd.Name
+	}
+	l := location.GetLocation(loc.Source, loc.Start)
+	return fmt.Sprintf("%s:%d:%d", fd.Name, l.Line, l.Column)
+}
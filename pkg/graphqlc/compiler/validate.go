@@ -0,0 +1,925 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+type typeKind int
+
+const (
+	scalarKind typeKind = iota + 1
+	objectKind
+	interfaceKind
+	unionKind
+	enumKind
+	inputObjectKind
+)
+
+func (k typeKind) String() string {
+	switch k {
+	case scalarKind:
+		return "scalar"
+	case objectKind:
+		return "object"
+	case interfaceKind:
+		return "interface"
+	case unionKind:
+		return "union"
+	case enumKind:
+		return "enum"
+	case inputObjectKind:
+		return "input object"
+	}
+	return "unknown"
+}
+
+// article returns the kind preceded by an indefinite article.
+func (k typeKind) article() string {
+	switch k {
+	case objectKind, interfaceKind, enumKind, inputObjectKind:
+		return "an " + k.String()
+	}
+	return "a " + k.String()
+}
+
+func (k typeKind) isComposite() bool {
+	return k == objectKind || k == interfaceKind || k == unionKind
+}
+
+func (k typeKind) isInput() bool {
+	return k == scalarKind || k == enumKind || k == inputObjectKind
+}
+
+func (k typeKind) isOutput() bool {
+	return k != inputObjectKind
+}
+
+// site is where a descriptor is defined, its path in a file.
+type site struct {
+	fd   *FileDescriptor
+	path []int32
+}
+
+func (s site) child(elems ...int32) site {
+	return site{fd: s.fd, path: child(s.path, elems...)}
+}
+
+// namedType is a type definition together with all of its extensions.
+type namedType struct {
+	name        string
+	kind        typeKind
+	site        site
+	synthetic   bool // added by graphqlc, not defined in any file
+	description string
+
+	fields      []*field      // object, interface
+	inputFields []*inputValue // input object
+	interfaces  []*typeRef    // object, interface
+	members     []*typeRef    // union
+	values      []*enumValue  // enum
+	directives  []*directiveUse
+}
+
+type field struct {
+	*graphqlc.FieldDefinitionDescriptorProto
+	site site
+}
+
+type inputValue struct {
+	*graphqlc.InputValueDefinitionDescriptorProto
+	site site
+}
+
+type enumValue struct {
+	*graphqlc.EnumValueDefinitionDescription
+	site site
+}
+
+type typeRef struct {
+	name string
+	site site
+}
+
+type directiveUse struct {
+	*graphqlc.DirectiveDescriptorProto
+	site     site
+	location graphqlc.TypeSystemDirectiveLocation
+}
+
+type directiveDefinition struct {
+	*graphqlc.DirectiveDefinitionDescriptorProto
+	site      site
+	locations map[graphqlc.TypeSystemDirectiveLocation]bool
+}
+
+// validator checks the type system of all files, type system extensions
+// included, against the validation rules of the GraphQL specification.
+type validator struct {
+	types      map[string]*namedType
+	typeOrder  []*namedType
+	directives map[string]*directiveDefinition
+	dirOrder   []*directiveDefinition
+	schema     *site       // schema definition, if any
+	roots      [3]*typeRef // query, mutation, subscription
+	schemaUses []*directiveUse
+
+	fileOrder map[string]int
+	diags     []*Diagnostic
+}
+
+// ValidateTypes checks the type system of all files, reporting every
+// violation and exiting the program if there are any.
+func (g *Generator) ValidateTypes() {
+	v := newValidator(g.files, g.schema)
+	v.validate()
+	g.reportDiagnostics(v.diags)
+}
+
+func newValidator(files []*FileDescriptor, schema *graphqlc.SchemaDescriptorProto) *validator {
+	v := &validator{
+		types:      make(map[string]*namedType),
+		directives: make(map[string]*directiveDefinition),
+		fileOrder:  make(map[string]int),
+	}
+	for i, fd := range files {
+		v.fileOrder[fd.Name] = i + 1
+	}
+	for _, fd := range files {
+		v.addFile(fd)
+	}
+	for _, fd := range files {
+		v.addExtensions(fd)
+	}
+
+	if schema != nil && v.roots[0] == nil {
+		if schema.Query != nil {
+			v.roots[0] = &typeRef{name: schema.Query.Name}
+		}
+		if schema.Mutation != nil {
+			v.roots[1] = &typeRef{name: schema.Mutation.Name}
+		}
+		if schema.Subscription != nil {
+			v.roots[2] = &typeRef{name: schema.Subscription.Name}
+		}
+	}
+	return v
+}
+
+func (v *validator) addType(t *namedType) {
+	v.types[t.name] = t
+	v.typeOrder = append(v.typeOrder, t)
+}
+
+func (v *validator) addFile(fd *FileDescriptor) {
+	if fd.Schema != nil {
+		s := site{fd: fd, path: []int32{fileSchemaField}}
+		v.schema = &s
+		v.addSchema(s, fd.Schema.Directives, schemaDirectivesField, schemaQueryField,
+			fd.Schema.Query, fd.Schema.Mutation, fd.Schema.Subscription)
+	}
+	for i, desc := range fd.Scalars {
+		s := site{fd: fd, path: []int32{fileScalarsField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: scalarKind, site: s, description: desc.Description}
+		t.directives = directiveUses(s.child(scalarDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_SCALAR)
+		v.addType(t)
+	}
+	for i, desc := range fd.Objects {
+		s := site{fd: fd, path: []int32{fileObjectsField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: objectKind, site: s, description: desc.Description, synthetic: desc.Synthesized}
+		t.directives = directiveUses(s.child(objectDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_OBJECT)
+		v.addObjectMembers(t, s, desc.Implements, desc.Fields)
+		v.addType(t)
+	}
+	for i, desc := range fd.Interfaces {
+		s := site{fd: fd, path: []int32{fileInterfacesField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: interfaceKind, site: s, description: desc.Description}
+		t.directives = directiveUses(s.child(interfaceDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_INTERFACE)
+		t.interfaces = typeRefs(s.child(interfaceImplementsField), desc.Implements)
+		t.fields = fields(s.child(interfaceFieldsField), desc.Fields)
+		v.addType(t)
+	}
+	for i, desc := range fd.Unions {
+		s := site{fd: fd, path: []int32{fileUnionsField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: unionKind, site: s, description: desc.Description}
+		t.directives = directiveUses(s.child(unionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_UNION)
+		t.members = typeRefs(s.child(unionMemberTypesField), desc.MemberTypes)
+		v.addType(t)
+	}
+	for i, desc := range fd.Enums {
+		s := site{fd: fd, path: []int32{fileEnumsField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: enumKind, site: s, description: desc.Description}
+		t.directives = directiveUses(s.child(enumDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_ENUM)
+		t.values = enumValues(s.child(enumValuesField), desc.Values)
+		v.addType(t)
+	}
+	for i, desc := range fd.InputObjects {
+		s := site{fd: fd, path: []int32{fileInputObjectsField, int32(i)}}
+		t := &namedType{name: desc.Name, kind: inputObjectKind, site: s, description: desc.Description}
+		t.directives = directiveUses(s.child(inputObjectDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_INPUT_OBJECT)
+		t.inputFields = inputValues(s.child(inputObjectFieldsField), desc.Fields)
+		v.addType(t)
+	}
+	for i, desc := range fd.Directives {
+		s := site{fd: fd, path: []int32{fileDirectivesField, int32(i)}}
+		def := &directiveDefinition{
+			DirectiveDefinitionDescriptorProto: desc,
+			site:                               s,
+			locations:                          make(map[graphqlc.TypeSystemDirectiveLocation]bool),
+		}
+		for _, location := range desc.Locations {
+			if location, ok := location.Location.(*graphqlc.DirectiveLocationDescriptorProto_TypeSystemLocation); ok {
+				def.locations[location.TypeSystemLocation] = true
+			}
+		}
+		v.directives[desc.Name] = def
+		v.dirOrder = append(v.dirOrder, def)
+	}
+}
+
+func (v *validator) addObjectMembers(t *namedType, s site, implements []*graphqlc.NamedTypeDescriptorProto, fieldDescs []*graphqlc.FieldDefinitionDescriptorProto) {
+	t.interfaces = append(t.interfaces, typeRefs(s.child(objectImplementsField), implements)...)
+	t.fields = append(t.fields, fields(s.child(objectFieldsField), fieldDescs)...)
+}
+
+func (v *validator) addSchema(s site, directives []*graphqlc.DirectiveDescriptorProto, directivesField, queryField int32, roots ...*graphqlc.NamedTypeDescriptorProto) {
+	v.schemaUses = append(v.schemaUses, directiveUses(s.child(directivesField), directives, graphqlc.TypeSystemDirectiveLocation_SCHEMA)...)
+	for i, root := range roots {
+		if root == nil {
+			continue
+		}
+		rootSite := s.child(queryField + int32(i))
+		if prev := v.roots[i]; prev != nil {
+			v.errorf(rootSite, "the %s root operation type is already defined as %q at %s", operationNames[i], prev.name, v.position(prev.site))
+			continue
+		}
+		v.roots[i] = &typeRef{name: root.Name, site: rootSite}
+	}
+}
+
+var operationNames = [3]string{"query", "mutation", "subscription"}
+
+func (v *validator) addExtensions(fd *FileDescriptor) {
+	for i, ext := range fd.TypeExtensions {
+		s := site{fd: fd, path: []int32{fileTypeExtensionsField, int32(i)}}
+		if schemaExt := ext.GetSchemaExtension(); schemaExt != nil {
+			s = s.child(typeSystemExtensionSchemaField)
+			v.addSchema(s, schemaExt.Directives, schemaExtensionDirectivesField, schemaExtensionQueryField,
+				schemaExt.Query, schemaExt.Mutation, schemaExt.Subscription)
+			continue
+		}
+
+		s = s.child(typeSystemExtensionTypeField)
+		switch typeExt := ext.GetTypeExtension().GetTypeExtension().(type) {
+		case *graphqlc.TypeExtensionDescriptorProto_ScalarTypeExtension:
+			s = s.child(typeExtensionScalarField)
+			desc := typeExt.ScalarTypeExtension
+			if t := v.extendedType(s, desc.Name, scalarKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(extensionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_SCALAR)...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+			s = s.child(typeExtensionObjectField)
+			desc := typeExt.ObjectTypeExtension
+			if t := v.extendedType(s, desc.Name, objectKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(objectDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_OBJECT)...)
+				v.addObjectMembers(t, s, desc.Implements, desc.Fields)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+			s = s.child(typeExtensionInterfaceField)
+			desc := typeExt.InterfaceTypeExtension
+			if t := v.extendedType(s, desc.Name, interfaceKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(extensionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_INTERFACE)...)
+				t.interfaces = append(t.interfaces, typeRefs(s.child(interfaceExtensionImplementsField), desc.Implements)...)
+				t.fields = append(t.fields, fields(s.child(extensionMembersField), desc.Fields)...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension:
+			s = s.child(typeExtensionUnionField)
+			desc := typeExt.UnionTypeExtension
+			if t := v.extendedType(s, desc.Name, unionKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(extensionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_UNION)...)
+				t.members = append(t.members, typeRefs(s.child(extensionMembersField), desc.MemberTypes)...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions:
+			s = s.child(typeExtensionEnumField)
+			desc := typeExt.EnumTypeExtions
+			if t := v.extendedType(s, desc.Name, enumKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(extensionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_ENUM)...)
+				t.values = append(t.values, enumValues(s.child(extensionMembersField), desc.Values)...)
+			}
+		case *graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension:
+			s = s.child(typeExtensionInputObjectField)
+			desc := typeExt.InputObjectTypeExtension
+			if t := v.extendedType(s, desc.Name, inputObjectKind); t != nil {
+				t.directives = append(t.directives, directiveUses(s.child(extensionDirectivesField), desc.Directives, graphqlc.TypeSystemDirectiveLocation_INPUT_OBJECT)...)
+				t.inputFields = append(t.inputFields, inputValues(s.child(extensionMembersField), desc.Fields)...)
+			}
+		}
+	}
+}
+
+// extendedType returns the type extended by an extension at s.
+func (v *validator) extendedType(s site, name string, kind typeKind) *namedType {
+	t, ok := v.types[name]
+	if !ok {
+		v.errorf(s, "cannot extend undefined type %q", name)
+		return nil
+	}
+	if t.kind != kind {
+		v.errorf(s, "cannot extend %s %q with %s extension", t.kind, name, kind.article())
+		return nil
+	}
+	return t
+}
+
+func fields(s site, descs []*graphqlc.FieldDefinitionDescriptorProto) []*field {
+	var fields []*field
+	for i, desc := range descs {
+		fields = append(fields, &field{FieldDefinitionDescriptorProto: desc, site: s.child(int32(i))})
+	}
+	return fields
+}
+
+func inputValues(s site, descs []*graphqlc.InputValueDefinitionDescriptorProto) []*inputValue {
+	var values []*inputValue
+	for i, desc := range descs {
+		values = append(values, &inputValue{InputValueDefinitionDescriptorProto: desc, site: s.child(int32(i))})
+	}
+	return values
+}
+
+func enumValues(s site, descs []*graphqlc.EnumValueDefinitionDescription) []*enumValue {
+	var values []*enumValue
+	for i, desc := range descs {
+		values = append(values, &enumValue{EnumValueDefinitionDescription: desc, site: s.child(int32(i))})
+	}
+	return values
+}
+
+func typeRefs(s site, descs []*graphqlc.NamedTypeDescriptorProto) []*typeRef {
+	var refs []*typeRef
+	for i, desc := range descs {
+		refs = append(refs, &typeRef{name: desc.Name, site: s.child(int32(i))})
+	}
+	return refs
+}
+
+func directiveUses(s site, descs []*graphqlc.DirectiveDescriptorProto, location graphqlc.TypeSystemDirectiveLocation) []*directiveUse {
+	var uses []*directiveUse
+	for i, desc := range descs {
+		uses = append(uses, &directiveUse{DirectiveDescriptorProto: desc, site: s.child(int32(i)), location: location})
+	}
+	return uses
+}
+
+func (v *validator) validate() {
+	v.validateSchema()
+	for _, t := range v.typeOrder {
+		v.validateType(t)
+	}
+	for _, def := range v.dirOrder {
+		v.validateDirectiveDefinition(def)
+	}
+	v.validateInputObjectCycles()
+
+	sort.SliceStable(v.diags, func(i, j int) bool {
+		a, b := v.diags[i], v.diags[j]
+		if a.File != b.File {
+			return v.fileOrder[a.File] < v.fileOrder[b.File]
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+}
+
+func (v *validator) validateSchema() {
+	if v.roots[0] == nil {
+		s := site{}
+		if v.schema != nil {
+			s = *v.schema
+		}
+		v.errorf(s, "the query root operation type must be provided")
+	}
+	for i, root := range v.roots {
+		if root == nil {
+			continue
+		}
+		if t := v.types[root.name]; t == nil || t.kind != objectKind {
+			v.errorf(root.site, "the %s root operation type %q must be an object type", operationNames[i], root.name)
+		}
+	}
+	v.validateDirectiveUses(v.schemaUses)
+}
+
+func (v *validator) validateType(t *namedType) {
+	v.validateName(t.site, t.name)
+	v.validateDirectiveUses(t.directives)
+
+	switch t.kind {
+	case objectKind, interfaceKind:
+		if len(t.fields) == 0 && !t.synthetic {
+			v.errorf(t.site, "%s %q must define one or more fields", t.kind, t.name)
+		}
+		v.validateFields(t)
+		v.validateImplements(t)
+	case unionKind:
+		if len(t.members) == 0 {
+			v.errorf(t.site, "union %q must have one or more member types", t.name)
+		}
+		seen := make(map[string]bool)
+		for _, member := range t.members {
+			memberType, ok := v.types[member.name]
+			switch {
+			case !ok:
+				v.errorf(member.site, "union %q member %q is not defined", t.name, member.name)
+			case memberType.kind != objectKind:
+				v.errorf(member.site, "union %q member %q must be an object type, it is %s", t.name, member.name, memberType.kind.article())
+			case seen[member.name]:
+				v.errorf(member.site, "union %q includes %q more than once", t.name, member.name)
+			}
+			seen[member.name] = true
+		}
+	case enumKind:
+		if len(t.values) == 0 {
+			v.errorf(t.site, "enum %q must define one or more values", t.name)
+		}
+		seen := make(map[string]bool)
+		for _, value := range t.values {
+			v.validateName(value.site, value.Value)
+			switch {
+			case value.Value == "true" || value.Value == "false" || value.Value == "null":
+				v.errorf(value.site, "enum %q cannot define the value %s", t.name, value.Value)
+			case seen[value.Value]:
+				v.errorf(value.site, "enum value %s.%s is already defined", t.name, value.Value)
+			}
+			seen[value.Value] = true
+			v.validateDirectiveUses(directiveUses(value.site.child(enumValueDirectivesField), value.Directives, graphqlc.TypeSystemDirectiveLocation_ENUM_VALUE))
+		}
+	case inputObjectKind:
+		if len(t.inputFields) == 0 {
+			v.errorf(t.site, "input object %q must define one or more fields", t.name)
+		}
+		v.validateInputValues(t.name+".", t.inputFields, graphqlc.TypeSystemDirectiveLocation_INPUT_FIELD_DEFINITION)
+	}
+}
+
+func (v *validator) validateFields(t *namedType) {
+	seen := make(map[string]bool)
+	for _, f := range t.fields {
+		v.validateName(f.site, f.Name)
+		if seen[f.Name] {
+			v.errorf(f.site, "field %s.%s is already defined", t.name, f.Name)
+		}
+		seen[f.Name] = true
+
+		if ft := v.validateTypeRef(f.site.child(fieldTypeField), f.Type); ft != nil && !ft.kind.isOutput() {
+			v.errorf(f.site.child(fieldTypeField), "field %s.%s must be an output type, %q is %s", t.name, f.Name, ft.name, ft.kind.article())
+		}
+		v.validateInputValues(t.name+"."+f.Name+"(", f.argumentValues(), graphqlc.TypeSystemDirectiveLocation_ARGUMENT_DEFINITION)
+		v.validateDirectiveUses(directiveUses(f.site.child(fieldDirectivesField), f.Directives, graphqlc.TypeSystemDirectiveLocation_FIELD_DEFINITION))
+	}
+}
+
+func (f *field) argumentValues() []*inputValue {
+	return inputValues(f.site.child(fieldArgumentsField), f.Arguments)
+}
+
+// validateInputValues validates arguments or input object fields, prefix
+// qualifies their names in messages.
+func (v *validator) validateInputValues(prefix string, values []*inputValue, location graphqlc.TypeSystemDirectiveLocation) {
+	suffix := ""
+	if strings.HasSuffix(prefix, "(") {
+		suffix = ":)"
+	}
+	seen := make(map[string]bool)
+	for _, value := range values {
+		name := prefix + value.Name + suffix
+		v.validateName(value.site, value.Name)
+		if seen[value.Name] {
+			v.errorf(value.site, "%s is already defined", name)
+		}
+		seen[value.Name] = true
+
+		vt := v.validateTypeRef(value.site.child(inputValueTypeField), value.Type)
+		if vt != nil && !vt.kind.isInput() {
+			v.errorf(value.site.child(inputValueTypeField), "%s must be an input type, %q is %s", name, vt.name, vt.kind.article())
+		} else if vt != nil && value.DefaultValue != nil {
+			for _, err := range v.coerceValue(value.DefaultValue, value.Type) {
+				v.errorf(value.site.child(inputValueDefaultValueField).child(err.path...), "%s has an invalid default value, %s", name, err.message)
+			}
+		}
+		v.validateDirectiveUses(directiveUses(value.site.child(inputValueDirectivesField), value.Directives, location))
+	}
+}
+
+// validateTypeRef checks the named type of a type reference is defined,
+// returning it.
+func (v *validator) validateTypeRef(s site, typ *graphqlc.TypeDescriptorProto) *namedType {
+	name := namedTypeName(typ)
+	if t, ok := v.types[name]; ok {
+		return t
+	}
+	v.errorf(s, "undefined type %q", name)
+	return nil
+}
+
+func (v *validator) validateImplements(t *namedType) {
+	seen := make(map[string]bool)
+	for _, ref := range t.interfaces {
+		iface, ok := v.types[ref.name]
+		switch {
+		case !ok:
+			v.errorf(ref.site, "%q implements undefined interface %q", t.name, ref.name)
+			continue
+		case iface.kind != interfaceKind:
+			v.errorf(ref.site, "%q cannot implement %q, it is %s", t.name, ref.name, iface.kind.article())
+			continue
+		case ref.name == t.name:
+			v.errorf(ref.site, "interface %q cannot implement itself", t.name)
+			continue
+		case seen[ref.name]:
+			v.errorf(ref.site, "%q implements %q more than once", t.name, ref.name)
+			continue
+		}
+		seen[ref.name] = true
+
+		// An implementation implements the interfaces of the interfaces it
+		// implements
+		for _, transitive := range iface.interfaces {
+			switch {
+			case transitive.name == t.name:
+				v.errorf(ref.site, "%q cannot implement %q, %q implements %q", t.name, ref.name, ref.name, t.name)
+			case !t.implements(transitive.name):
+				v.errorf(ref.site, "%q must also implement %q, it is implemented by %q", t.name, transitive.name, ref.name)
+			}
+		}
+
+		for _, ifaceField := range iface.fields {
+			f := t.field(ifaceField.Name)
+			if f == nil {
+				v.errorf(ref.site, "%s.%s expected by interface %q is not provided", t.name, ifaceField.Name, iface.name)
+				continue
+			}
+			if !v.isSubType(f.Type, ifaceField.Type) {
+				v.errorf(f.site.child(fieldTypeField), "%s.%s is type %s, interface field %s.%s is type %s",
+					t.name, f.Name, typeString(f.Type), iface.name, ifaceField.Name, typeString(ifaceField.Type))
+			}
+			for _, ifaceArg := range ifaceField.argumentValues() {
+				arg := f.argument(ifaceArg.Name)
+				if arg == nil {
+					v.errorf(f.site, "%s.%s(%s:) expected by interface %q is not provided", t.name, f.Name, ifaceArg.Name, iface.name)
+					continue
+				}
+				if typeString(arg.Type) != typeString(ifaceArg.Type) {
+					v.errorf(arg.site.child(inputValueTypeField), "%s.%s(%s:) is type %s, interface argument %s.%s(%s:) is type %s",
+						t.name, f.Name, arg.Name, typeString(arg.Type), iface.name, ifaceField.Name, ifaceArg.Name, typeString(ifaceArg.Type))
+				}
+			}
+			for _, arg := range f.argumentValues() {
+				if ifaceField.argument(arg.Name) == nil && isNonNull(arg.Type) && arg.DefaultValue == nil {
+					v.errorf(arg.site, "%s.%s(%s:) is required, it cannot be added to field %s.%s of interface %q",
+						t.name, f.Name, arg.Name, iface.name, ifaceField.Name, iface.name)
+				}
+			}
+		}
+	}
+}
+
+func (t *namedType) implements(name string) bool {
+	for _, ref := range t.interfaces {
+		if ref.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *namedType) field(name string) *field {
+	for _, f := range t.fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (t *namedType) inputField(name string) *inputValue {
+	for _, f := range t.inputFields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (def *directiveDefinition) argument(name string) *graphqlc.InputValueDefinitionDescriptorProto {
+	for _, arg := range def.Arguments {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+func (f *field) argument(name string) *inputValue {
+	for _, arg := range f.argumentValues() {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// isSubType reports whether sub is a valid implementation of super, the
+// type of an interface field.
+func (v *validator) isSubType(sub, super *graphqlc.TypeDescriptorProto) bool {
+	if superOf, ok := nonNullOf(super); ok {
+		subOf, ok := nonNullOf(sub)
+		return ok && v.isSubType(subOf, superOf)
+	}
+	if subOf, ok := nonNullOf(sub); ok {
+		return v.isSubType(subOf, super)
+	}
+	if superList := super.GetListType(); superList != nil {
+		subList := sub.GetListType()
+		return subList != nil && v.isSubType(subList.Type, superList.Type)
+	}
+	if sub.GetListType() != nil {
+		return false
+	}
+
+	subName, superName := namedTypeName(sub), namedTypeName(super)
+	if subName == superName {
+		return true
+	}
+	subType, superType := v.types[subName], v.types[superName]
+	if subType == nil || superType == nil {
+		return false
+	}
+	switch superType.kind {
+	case interfaceKind:
+		return subType.implements(superName)
+	case unionKind:
+		for _, ref := range superType.members {
+			if ref.name == subName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *validator) validateDirectiveDefinition(def *directiveDefinition) {
+	v.validateName(def.site, def.Name)
+	args := inputValues(def.site.child(directiveDefinitionArgumentsField), def.Arguments)
+	v.validateInputValues("@"+def.Name+"(", args, graphqlc.TypeSystemDirectiveLocation_ARGUMENT_DEFINITION)
+	for _, arg := range args {
+		for _, use := range arg.Directives {
+			if use.Name == def.Name {
+				v.errorf(arg.site, "directive @%s cannot reference itself", def.Name)
+			} else if v.reachesDirective(use.Name, def.Name, make(map[string]bool)) {
+				v.errorf(arg.site, "directive @%s cannot reference itself through @%s", def.Name, use.Name)
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	for i, location := range def.Locations {
+		name := directiveLocationName(location)
+		if seen[name] {
+			v.errorf(def.site.child(directiveDefinitionLocationsField, int32(i)), "directive @%s location %s is already listed", def.Name, name)
+		}
+		seen[name] = true
+	}
+}
+
+// reachesDirective reports whether the directive named from uses the
+// directive named to on its arguments, directly or through the directives
+// it uses.
+func (v *validator) reachesDirective(from, to string, visited map[string]bool) bool {
+	def, ok := v.directives[from]
+	if !ok || visited[from] {
+		return false
+	}
+	visited[from] = true
+	for _, arg := range def.Arguments {
+		for _, use := range arg.Directives {
+			if use.Name == to || v.reachesDirective(use.Name, to, visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *validator) validateDirectiveUses(uses []*directiveUse) {
+	seen := make(map[string]bool)
+	for _, use := range uses {
+		def, ok := v.directives[use.Name]
+		if !ok {
+			v.errorf(use.site, "undefined directive @%s", use.Name)
+			continue
+		}
+		if !def.locations[use.location] {
+			v.errorf(use.site, "directive @%s may not be used on %s", use.Name, use.location)
+		}
+		if seen[use.Name] && !def.Repeatable {
+			v.errorf(use.site, "directive @%s may only be used once at this location", use.Name)
+		}
+		seen[use.Name] = true
+		v.validateDirectiveArguments(use, def)
+		completeDirective(use.DirectiveDescriptorProto, def)
+	}
+}
+
+// validateDirectiveArguments checks the arguments of a directive use are
+// defined, given once, of the types of their definitions and that every
+// required argument is given.
+func (v *validator) validateDirectiveArguments(use *directiveUse, def *directiveDefinition) {
+	given := make(map[string]bool)
+	for i, arg := range use.Arguments {
+		if arg.Defaulted {
+			continue
+		}
+		s := use.site.child(directiveArgumentsField, int32(i))
+		if given[arg.Name] {
+			v.errorf(s, "argument %q of directive @%s is given more than once", arg.Name, use.Name)
+			continue
+		}
+		given[arg.Name] = true
+
+		argDef := def.argument(arg.Name)
+		if argDef == nil {
+			v.errorf(s, "directive @%s has no argument %q", use.Name, arg.Name)
+			continue
+		}
+		for _, err := range v.coerceValue(arg.Value, argDef.Type) {
+			v.errorf(s.child(argumentValueField).child(err.path...), "argument %q of directive @%s has an invalid value, %s", arg.Name, use.Name, err.message)
+		}
+	}
+	for _, argDef := range def.Arguments {
+		if !given[argDef.Name] && isNonNull(argDef.Type) && argDef.DefaultValue == nil {
+			v.errorf(use.site, "directive @%s requires argument %q of type %s", use.Name, argDef.Name, typeString(argDef.Type))
+		}
+	}
+}
+
+// completeDirective links a directive use to the file defining it and adds
+// the arguments which are not given and have a default value.
+func completeDirective(desc *graphqlc.DirectiveDescriptorProto, def *directiveDefinition) {
+	desc.File = def.site.fd.Name
+	for _, argDef := range def.Arguments {
+		if argDef.DefaultValue == nil || directiveArgument(desc, argDef.Name) != nil {
+			continue
+		}
+		desc.Arguments = append(desc.Arguments, &graphqlc.ArgumentDescriptorProto{
+			Name:      argDef.Name,
+			Value:     proto.Clone(argDef.DefaultValue).(*graphqlc.ValueDescriptorProto),
+			Defaulted: true,
+		})
+	}
+}
+
+func directiveArgument(desc *graphqlc.DirectiveDescriptorProto, name string) *graphqlc.ArgumentDescriptorProto {
+	for _, arg := range desc.Arguments {
+		if arg.Name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// validateInputObjectCycles reports input objects which reference
+// themselves through non-null fields, such objects cannot be provided.
+func (v *validator) validateInputObjectCycles() {
+	visited := make(map[string]bool)
+	onPath := make(map[string]int)
+	var path []*inputValue
+	var owners []string
+
+	var visit func(t *namedType)
+	visit = func(t *namedType) {
+		if visited[t.name] {
+			return
+		}
+		visited[t.name] = true
+		onPath[t.name] = len(path)
+		for _, f := range t.inputFields {
+			inner, ok := nonNullOf(f.Type)
+			if !ok || inner.GetNamedType() == nil {
+				continue
+			}
+			ft := v.types[inner.GetNamedType().Name]
+			if ft == nil || ft.kind != inputObjectKind {
+				continue
+			}
+			path = append(path, f)
+			owners = append(owners, t.name)
+			if i, ok := onPath[ft.name]; ok {
+				var cycle []string
+				for j := i; j < len(path); j++ {
+					cycle = append(cycle, owners[j]+"."+path[j].Name)
+				}
+				v.errorf(path[i].site, "input object %q references itself through non-null fields %s", ft.name, strings.Join(cycle, ", "))
+			} else {
+				visit(ft)
+			}
+			path = path[:len(path)-1]
+			owners = owners[:len(owners)-1]
+		}
+		delete(onPath, t.name)
+	}
+
+	for _, t := range v.typeOrder {
+		if t.kind == inputObjectKind {
+			visit(t)
+		}
+	}
+}
+
+func (v *validator) validateName(s site, name string) {
+	if strings.HasPrefix(name, "__") {
+		v.errorf(s, "name %q must not begin with \"__\", it is reserved for introspection", name)
+	}
+}
+
+func (v *validator) errorf(s site, format string, args ...interface{}) {
+	d := &Diagnostic{Message: fmt.Sprintf(format, args...)}
+	if s.fd != nil {
+		d = newDiagnostic(s.fd, s.fd.locations.find(s.path), format, args...)
+	}
+	v.diags = append(v.diags, d)
+}
+
+func (v *validator) position(s site) string {
+	if s.fd == nil {
+		return "graphqlc"
+	}
+	return position(s.fd, s.fd.locations.find(s.path))
+}
+
+// Type helpers
+
+// namedTypeName returns the name of the named type wrapped by typ.
+func namedTypeName(typ *graphqlc.TypeDescriptorProto) string {
+	for typ != nil {
+		switch t := typ.Type.(type) {
+		case *graphqlc.TypeDescriptorProto_NamedType:
+			return t.NamedType.Name
+		case *graphqlc.TypeDescriptorProto_ListType:
+			typ = t.ListType.Type
+		case *graphqlc.TypeDescriptorProto_NonNullType:
+			typ, _ = nonNullOf(typ)
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// nonNullOf returns the type wrapped by a non-null type.
+func nonNullOf(typ *graphqlc.TypeDescriptorProto) (*graphqlc.TypeDescriptorProto, bool) {
+	nonNull := typ.GetNonNullType()
+	if nonNull == nil {
+		return nil, false
+	}
+	switch t := nonNull.Type.(type) {
+	case *graphqlc.NonNullTypeDescriptorProto_NamedType:
+		return &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_NamedType{NamedType: t.NamedType}}, true
+	case *graphqlc.NonNullTypeDescriptorProto_ListType:
+		return &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_ListType{ListType: t.ListType}}, true
+	}
+	return nil, false
+}
+
+func isNonNull(typ *graphqlc.TypeDescriptorProto) bool {
+	return typ.GetNonNullType() != nil
+}
+
+// typeString formats a type as it is written in GraphQL, e.g. [Int!]!.
+func typeString(typ *graphqlc.TypeDescriptorProto) string {
+	switch t := typ.GetType().(type) {
+	case *graphqlc.TypeDescriptorProto_NamedType:
+		return t.NamedType.Name
+	case *graphqlc.TypeDescriptorProto_ListType:
+		return "[" + typeString(t.ListType.Type) + "]"
+	case *graphqlc.TypeDescriptorProto_NonNullType:
+		inner, _ := nonNullOf(typ)
+		return typeString(inner) + "!"
+	}
+	return ""
+}
+
+func directiveLocationName(location *graphqlc.DirectiveLocationDescriptorProto) string {
+	switch l := location.Location.(type) {
+	case *graphqlc.DirectiveLocationDescriptorProto_ExecutableLocation:
+		return l.ExecutableLocation.String()
+	case *graphqlc.DirectiveLocationDescriptorProto_TypeSystemLocation:
+		return l.TypeSystemLocation.String()
+	}
+	return ""
+}
@@ -0,0 +1,669 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/visitor"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// executableRules returns the rules executable documents are validated
+// with. Unused fragments are not checked per document, a fragment may be
+// used by any file importing the file defining it, see unusedFragments.
+// Fragment cycles are checked first, see ValidateOperations.
+func executableRules(v *validator, variableDirectives map[*ast.VariableDefinition][]*ast.Directive) []graphql.ValidationRuleFn {
+	return []graphql.ValidationRuleFn{
+		graphql.FieldsOnCorrectTypeRule,
+		graphql.FragmentsOnCompositeTypesRule,
+		graphql.KnownArgumentNamesRule,
+		graphql.KnownDirectivesRule,
+		graphql.KnownFragmentNamesRule,
+		graphql.KnownTypeNamesRule,
+		graphql.LoneAnonymousOperationRule,
+		graphql.NoUndefinedVariablesRule,
+		graphql.NoUnusedVariablesRule,
+		graphql.OverlappingFieldsCanBeMergedRule,
+		graphql.PossibleFragmentSpreadsRule,
+		graphql.ScalarLeafsRule,
+		graphql.UniqueArgumentNamesRule,
+		graphql.UniqueFragmentNamesRule,
+		graphql.UniqueInputFieldNamesRule,
+		graphql.UniqueOperationNamesRule,
+		graphql.UniqueVariableNamesRule,
+		graphql.VariablesAreInputTypesRule,
+		graphql.VariablesInAllowedPositionRule,
+		argumentValuesRule(v),
+		knownOperationTypesRule,
+		requiredArgumentsRule,
+		singleFieldSubscriptionsRule,
+		uniqueDirectivesPerLocationRule(v),
+		variableDefaultValuesRule(v),
+		variableDirectivesRule(v, variableDirectives),
+	}
+}
+
+// ValidateOperations checks the operations and fragments of all files
+// against the schema, reporting every violation and exiting the program if
+// there are any. Operations are checked as they are in the source, files
+// added by transformers are not checked.
+func (g *Generator) ValidateOperations() {
+	g.reportDiagnostics(g.operationDiagnostics())
+}
+
+// operationDiagnostics returns the violations of the operations and
+// fragments of all files.
+func (g *Generator) operationDiagnostics() []*Diagnostic {
+	var files []*FileDescriptor
+	for _, fd := range g.files {
+		if fd.doc != nil && (len(fd.Operations) > 0 || len(fd.Fragments) > 0) {
+			files = append(files, fd)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	// The Query type synthesized for a schema without one has no fields,
+	// graphql-go builds no schema from it. There is nothing to query, the
+	// operations and fragments are not validated.
+	if query, ok := g.typeMap.object(g.schema.GetQuery().GetName()); !ok || query.Synthesized {
+		return nil
+	}
+
+	v := newValidator(g.files, g.schema)
+	schema, err := buildGraphqlSchema(v)
+	if err != nil {
+		g.Error(err)
+	}
+
+	variableDirectives := make(map[*ast.VariableDefinition][]*ast.Directive)
+	for _, fd := range files {
+		for def, directives := range fd.variableDirectives {
+			variableDirectives[def] = directives
+		}
+	}
+	rules := executableRules(v, variableDirectives)
+	var diags []*Diagnostic
+	reported := make(map[string]bool)
+	for _, fd := range files {
+		doc := g.executableDocument(fd)
+		related := relatedDefinitions(v, doc)
+		// Other rules, such as OverlappingFieldsCanBeMergedRule, recurse
+		// into fragment spreads without end if fragments form a cycle
+		result := graphql.ValidateDocument(&schema, doc, []graphql.ValidationRuleFn{graphql.NoFragmentCyclesRule})
+		if len(result.Errors) == 0 {
+			result = graphql.ValidateDocument(&schema, doc, rules)
+		}
+		for _, err := range result.Errors {
+			errDiags := executableDiagnostics(fd, err, related)
+			// Fragments of imported files are validated with every file
+			// importing them
+			if key := errDiags[0].Error(); !reported[key] {
+				reported[key] = true
+				diags = append(diags, errDiags...)
+			}
+		}
+	}
+	return append(diags, unusedFragments(files)...)
+}
+
+// executableDocument returns the operations and fragments of a file and the
+// fragments of every file it imports, directly or indirectly.
+func (g *Generator) executableDocument(fd *FileDescriptor) *ast.Document {
+	doc := &ast.Document{Kind: kinds.Document, Loc: fd.doc.Loc}
+	for _, node := range fd.doc.Definitions {
+		switch node.(type) {
+		case *ast.OperationDefinition, *ast.FragmentDefinition:
+			doc.Definitions = append(doc.Definitions, node)
+		}
+	}
+
+	visited := map[string]bool{fd.Name: true}
+	var addImports func(fd *FileDescriptor)
+	addImports = func(fd *FileDescriptor) {
+		for _, name := range fd.Dependency {
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+			dep, ok := findFile(g.files, name)
+			if !ok || dep.doc == nil {
+				continue
+			}
+			for _, node := range dep.doc.Definitions {
+				if def, ok := node.(*ast.FragmentDefinition); ok {
+					doc.Definitions = append(doc.Definitions, def)
+				}
+			}
+			addImports(dep)
+		}
+	}
+	addImports(fd)
+	return doc
+}
+
+// executableDiagnostics converts a validation error to diagnostics. The
+// error is reported at its first node, followed by notes for its other
+// nodes and for the schema definition the first node refers to.
+func executableDiagnostics(fd *FileDescriptor, err gqlerrors.FormattedError, related map[ast.Node]*relatedDefinition) []*Diagnostic {
+	message := strings.TrimSuffix(err.Message, ".")
+	gqlErr, ok := err.OriginalError().(*gqlerrors.Error)
+	if !ok || len(gqlErr.Nodes) == 0 {
+		d := &Diagnostic{File: fd.Name, Message: message}
+		if len(err.Locations) > 0 {
+			d.Line, d.Column = err.Locations[0].Line, err.Locations[0].Column
+		}
+		return []*Diagnostic{d}
+	}
+
+	diags := []*Diagnostic{newSourceDiagnostic(gqlErr.Nodes[0].GetLoc(), "%s", message)}
+	for _, node := range gqlErr.Nodes[1:] {
+		d := newSourceDiagnostic(node.GetLoc(), "related location")
+		d.Note = true
+		diags = append(diags, d)
+	}
+	if def, ok := related[gqlErr.Nodes[0]]; ok {
+		d := newDiagnostic(def.site.fd, def.site.fd.locations.find(def.site.path), "%s", def.message)
+		d.Note = true
+		diags = append(diags, d)
+	}
+	return diags
+}
+
+// relatedDefinition is the schema definition a node of an executable
+// document refers to.
+type relatedDefinition struct {
+	site    site
+	message string
+}
+
+// relatedDefinitions maps the nodes of an executable document to the schema
+// definitions they refer to.
+func relatedDefinitions(v *validator, doc *ast.Document) map[ast.Node]*relatedDefinition {
+	related := make(map[ast.Node]*relatedDefinition)
+	add := func(node ast.Node, s site, format string, args ...interface{}) {
+		if s.fd == nil || s.fd.locations.find(s.path) == nil {
+			return
+		}
+		related[node] = &relatedDefinition{site: s, message: fmt.Sprintf(format, args...)}
+	}
+	addType := func(node ast.Node, t *namedType) {
+		if t != nil {
+			add(node, t.site, "%s %q is defined here", t.kind, t.name)
+		}
+	}
+	addDirectives := func(directives []*ast.Directive) {
+		for _, directive := range directives {
+			if def, ok := v.directives[directive.Name.Value]; ok {
+				add(directive, def.site, "directive @%s is defined here", def.Name)
+			}
+		}
+	}
+
+	var addSelectionSet func(t *namedType, node *ast.SelectionSet)
+	addSelectionSet = func(t *namedType, node *ast.SelectionSet) {
+		if node == nil {
+			return
+		}
+		for _, selection := range node.Selections {
+			switch def := selection.(type) {
+			case *ast.Field:
+				addDirectives(def.Directives)
+				var f *field
+				if t != nil {
+					f = t.field(def.Name.Value)
+				}
+				if f == nil {
+					addType(def, t)
+					addSelectionSet(nil, def.SelectionSet)
+					continue
+				}
+				add(def, f.site, "field %s.%s is defined here", t.name, f.Name)
+				for _, arg := range def.Arguments {
+					argDef := f.argument(arg.Name.Value)
+					if argDef == nil {
+						add(arg, f.site, "field %s.%s is defined here", t.name, f.Name)
+						continue
+					}
+					add(arg, argDef.site, "argument %s.%s(%s:) is defined here", t.name, f.Name, argDef.Name)
+					add(arg.Value, argDef.site, "argument %s.%s(%s:) is defined here", t.name, f.Name, argDef.Name)
+				}
+				addSelectionSet(v.types[namedTypeName(f.Type)], def.SelectionSet)
+			case *ast.InlineFragment:
+				addDirectives(def.Directives)
+				fragmentType := t
+				if def.TypeCondition != nil {
+					fragmentType = v.types[def.TypeCondition.Name.Value]
+					addType(def.TypeCondition, fragmentType)
+				}
+				addType(def, fragmentType)
+				addSelectionSet(fragmentType, def.SelectionSet)
+			case *ast.FragmentSpread:
+				addDirectives(def.Directives)
+			}
+		}
+	}
+
+	addVariables := func(defs []*ast.VariableDefinition) {
+		for _, def := range defs {
+			t := v.types[astNamedTypeName(def.Type)]
+			addType(def, t)
+			addType(def.Type, t)
+		}
+	}
+
+	for _, node := range doc.Definitions {
+		switch def := node.(type) {
+		case *ast.OperationDefinition:
+			var root *namedType
+			for i, name := range operationNames {
+				if def.Operation == name && v.roots[i] != nil {
+					root = v.types[v.roots[i].name]
+				}
+			}
+			addType(def, root)
+			addVariables(def.VariableDefinitions)
+			addDirectives(def.Directives)
+			addSelectionSet(root, def.SelectionSet)
+		case *ast.FragmentDefinition:
+			t := v.types[def.TypeCondition.Name.Value]
+			addType(def, t)
+			addType(def.TypeCondition, t)
+			addDirectives(def.Directives)
+			addSelectionSet(t, def.SelectionSet)
+		}
+	}
+	return related
+}
+
+// astNamedTypeName returns the name of the named type wrapped by typ.
+func astNamedTypeName(typ ast.Type) string {
+	for {
+		switch t := typ.(type) {
+		case *ast.Named:
+			return t.Name.Value
+		case *ast.List:
+			typ = t.Type
+		case *ast.NonNull:
+			typ = t.Type
+		default:
+			return ""
+		}
+	}
+}
+
+// unusedFragments reports fragments which are not used by any operation,
+// directly or through other fragments.
+func unusedFragments(files []*FileDescriptor) []*Diagnostic {
+	fragments := make(map[string]*ast.FragmentDefinition)
+	var operations []*ast.OperationDefinition
+	for _, fd := range files {
+		for _, node := range fd.doc.Definitions {
+			switch def := node.(type) {
+			case *ast.OperationDefinition:
+				operations = append(operations, def)
+			case *ast.FragmentDefinition:
+				fragments[def.Name.Value] = def
+			}
+		}
+	}
+
+	used := make(map[string]bool)
+	var use func(node *ast.SelectionSet)
+	use = func(node *ast.SelectionSet) {
+		if node == nil {
+			return
+		}
+		for _, selection := range node.Selections {
+			switch def := selection.(type) {
+			case *ast.Field:
+				use(def.SelectionSet)
+			case *ast.InlineFragment:
+				use(def.SelectionSet)
+			case *ast.FragmentSpread:
+				name := def.Name.Value
+				if fragment, ok := fragments[name]; ok && !used[name] {
+					used[name] = true
+					use(fragment.SelectionSet)
+				}
+			}
+		}
+	}
+	for _, op := range operations {
+		use(op.SelectionSet)
+	}
+
+	var diags []*Diagnostic
+	for _, fd := range files {
+		for _, node := range fd.doc.Definitions {
+			if def, ok := node.(*ast.FragmentDefinition); ok && !used[def.Name.Value] {
+				diags = append(diags, newDiagnostic(fd, def.Loc, "fragment %q is never used", def.Name.Value))
+			}
+		}
+	}
+	return diags
+}
+
+// knownOperationTypesRule checks the schema has a root operation type for
+// each operation.
+func knownOperationTypesRule(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.OperationDefinition)
+						if !ok {
+							return visitor.ActionNoChange, nil
+						}
+						schema := context.Schema()
+						if (node.Operation == ast.OperationTypeMutation && schema.MutationType() == nil) ||
+							(node.Operation == ast.OperationTypeSubscription && schema.SubscriptionType() == nil) {
+							reportValidationError(context, fmt.Sprintf("Schema is not configured for %ss.", node.Operation), []ast.Node{node})
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+// singleFieldSubscriptionsRule checks a subscription selects exactly one
+// top level field.
+func singleFieldSubscriptionsRule(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.OperationDefinition)
+						if !ok || node.Operation != ast.OperationTypeSubscription || node.SelectionSet == nil {
+							return visitor.ActionNoChange, nil
+						}
+						if selections := node.SelectionSet.Selections; len(selections) > 1 {
+							name := "Anonymous Subscription"
+							if node.Name != nil {
+								name = fmt.Sprintf("Subscription %q", node.Name.Value)
+							}
+							var nodes []ast.Node
+							for _, selection := range selections[1:] {
+								if node, ok := selection.(ast.Node); ok {
+									nodes = append(nodes, node)
+								}
+							}
+							reportValidationError(context, name+" must select only one top level field.", nodes)
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+// uniqueDirectivesPerLocationRule checks a directive which is not
+// repeatable is used at most once at each location.
+func uniqueDirectivesPerLocationRule(v *validator) graphql.ValidationRuleFn {
+	return func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		return uniqueDirectivesPerLocation(v, context)
+	}
+}
+
+func uniqueDirectivesPerLocation(v *validator, context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	check := func(directives []*ast.Directive) {
+		seen := make(map[string]*ast.Directive)
+		for _, directive := range directives {
+			name := directive.Name.Value
+			if def := v.directives[name]; def != nil && def.Repeatable {
+				continue
+			}
+			if prev, ok := seen[name]; ok {
+				reportValidationError(context, fmt.Sprintf("The directive %q can only be used once at this location.", name), []ast.Node{directive, prev})
+				continue
+			}
+			seen[name] = directive
+		}
+	}
+	visit := func(p visitor.VisitFuncParams) (string, interface{}) {
+		switch node := p.Node.(type) {
+		case *ast.OperationDefinition:
+			check(node.Directives)
+		case *ast.FragmentDefinition:
+			check(node.Directives)
+		case *ast.Field:
+			check(node.Directives)
+		case *ast.FragmentSpread:
+			check(node.Directives)
+		case *ast.InlineFragment:
+			check(node.Directives)
+		}
+		return visitor.ActionNoChange, nil
+	}
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {Kind: visit},
+				kinds.FragmentDefinition:  {Kind: visit},
+				kinds.Field:               {Kind: visit},
+				kinds.FragmentSpread:      {Kind: visit},
+				kinds.InlineFragment:      {Kind: visit},
+			},
+		},
+	}
+}
+
+// requiredArgumentsRule checks every required argument of a field or
+// directive is given. Unlike graphql-go, an argument with a default value
+// is not required.
+func requiredArgumentsRule(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	visit := func(p visitor.VisitFuncParams) (string, interface{}) {
+		switch node := p.Node.(type) {
+		case *ast.Field:
+			if def := context.FieldDef(); def != nil {
+				for _, argDef := range def.Args {
+					if _, ok := argDef.Type.(*graphql.NonNull); ok && argDef.DefaultValue == nil && !hasArgument(node.Arguments, argDef.Name()) {
+						reportValidationError(context, fmt.Sprintf("Field %q argument %q of type %q is required but not provided.", node.Name.Value, argDef.Name(), argDef.Type), []ast.Node{node})
+					}
+				}
+			}
+		case *ast.Directive:
+			if def := context.Directive(); def != nil {
+				for _, argDef := range def.Args {
+					if _, ok := argDef.Type.(*graphql.NonNull); ok && argDef.DefaultValue == nil && !hasArgument(node.Arguments, argDef.Name()) {
+						reportValidationError(context, fmt.Sprintf("Directive \"@%s\" argument %q of type %q is required but not provided.", node.Name.Value, argDef.Name(), argDef.Type), []ast.Node{node})
+					}
+				}
+			}
+		}
+		return visitor.ActionNoChange, nil
+	}
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field:     {Kind: visit},
+				kinds.Directive: {Kind: visit},
+			},
+		},
+	}
+}
+
+func hasArgument(args []*ast.Argument, name string) bool {
+	for _, arg := range args {
+		if arg.Name.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// variableDirectivesRule checks the directives of variable definitions,
+// which graphql-go does not know of.
+func variableDirectivesRule(v *validator, variableDirectives map[*ast.VariableDefinition][]*ast.Directive) graphql.ValidationRuleFn {
+	return func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		visit := func(p visitor.VisitFuncParams) (string, interface{}) {
+			node, ok := p.Node.(*ast.VariableDefinition)
+			if !ok {
+				return visitor.ActionNoChange, nil
+			}
+			seen := make(map[string]*ast.Directive)
+			for _, directive := range variableDirectives[node] {
+				name := directive.Name.Value
+				def := v.directives[name]
+				if def == nil {
+					reportValidationError(context, fmt.Sprintf("Unknown directive %q.", name), []ast.Node{directive})
+					continue
+				}
+				if !hasExecutableLocation(def, graphqlc.ExecutableDirectiveLocation_VARIABLE_DEFINITION) {
+					reportValidationError(context, fmt.Sprintf("Directive %q may not be used on %s.", name, graphqlc.ExecutableDirectiveLocation_VARIABLE_DEFINITION), []ast.Node{directive})
+				}
+				if prev, ok := seen[name]; ok && !def.Repeatable {
+					reportValidationError(context, fmt.Sprintf("The directive %q can only be used once at this location.", name), []ast.Node{directive, prev})
+				}
+				seen[name] = directive
+				for _, arg := range directive.Arguments {
+					argDef := def.argument(arg.Name.Value)
+					if argDef == nil {
+						reportValidationError(context, fmt.Sprintf("Unknown argument %q on directive \"@%s\".", arg.Name.Value, name), []ast.Node{arg})
+						continue
+					}
+					for _, err := range coerceValueNode(v, arg.Value, argDef.Type) {
+						reportValidationError(context, fmt.Sprintf("Argument %q has invalid value %s: %s.", arg.Name.Value, parser.RawValue(arg.Value), err.message), []ast.Node{err.node})
+					}
+				}
+				for _, argDef := range def.Arguments {
+					if isNonNull(argDef.Type) && argDef.DefaultValue == nil && !hasArgument(directive.Arguments, argDef.Name) {
+						reportValidationError(context, fmt.Sprintf("Directive \"@%s\" argument %q of type %q is required but not provided.", name, argDef.Name, typeString(argDef.Type)), []ast.Node{directive})
+					}
+				}
+			}
+			return visitor.ActionNoChange, nil
+		}
+		return &graphql.ValidationRuleInstance{
+			VisitorOpts: &visitor.VisitorOptions{
+				KindFuncMap: map[string]visitor.NamedVisitFuncs{
+					kinds.VariableDefinition: {Kind: visit},
+				},
+			},
+		}
+	}
+}
+
+// argumentValuesRule checks the constant parts of argument values can be
+// coerced to the types of the arguments, graphql-go does not know of null.
+func argumentValuesRule(v *validator) graphql.ValidationRuleFn {
+	return func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		visit := func(p visitor.VisitFuncParams) (string, interface{}) {
+			node, ok := p.Node.(*ast.Argument)
+			if !ok || context.Argument() == nil {
+				return visitor.ActionNoChange, nil
+			}
+			for _, err := range coerceValueNode(v, node.Value, parser.TypeDescriptor(astType(context.Argument().Type))) {
+				reportValidationError(context, fmt.Sprintf("Argument %q has invalid value %s: %s.", node.Name.Value, parser.RawValue(node.Value), err.message), []ast.Node{err.node})
+			}
+			return visitor.ActionNoChange, nil
+		}
+		return &graphql.ValidationRuleInstance{
+			VisitorOpts: &visitor.VisitorOptions{
+				KindFuncMap: map[string]visitor.NamedVisitFuncs{
+					kinds.Argument: {Kind: visit},
+				},
+			},
+		}
+	}
+}
+
+// variableDefaultValuesRule checks the default values of variables can be
+// coerced to the types of the variables. Unlike graphql-go, a variable of a
+// non-null type may have a default value.
+func variableDefaultValuesRule(v *validator) graphql.ValidationRuleFn {
+	return func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		visit := func(p visitor.VisitFuncParams) (string, interface{}) {
+			node, ok := p.Node.(*ast.VariableDefinition)
+			if !ok || node.DefaultValue == nil {
+				return visitor.ActionNoChange, nil
+			}
+			for _, err := range coerceValueNode(v, node.DefaultValue, parser.TypeDescriptor(node.Type)) {
+				reportValidationError(context, fmt.Sprintf("Variable \"$%s\" has invalid default value %s: %s.", node.Variable.Name.Value, parser.RawValue(node.DefaultValue), err.message), []ast.Node{err.node})
+			}
+			return visitor.ActionNoChange, nil
+		}
+		return &graphql.ValidationRuleInstance{
+			VisitorOpts: &visitor.VisitorOptions{
+				KindFuncMap: map[string]visitor.NamedVisitFuncs{
+					kinds.VariableDefinition: {Kind: visit},
+				},
+			},
+		}
+	}
+}
+
+// valueNodeError is a value of an executable document which cannot be
+// coerced to its type.
+type valueNodeError struct {
+	node    ast.Node
+	message string
+}
+
+// coerceValueNode coerces the value node of an executable document to typ,
+// see coerceValue.
+func coerceValueNode(v *validator, node ast.Value, typ *graphqlc.TypeDescriptorProto) []valueNodeError {
+	if typ == nil {
+		return nil
+	}
+	value := parser.ValueDescriptor(node)
+	var errs []valueNodeError
+	for _, err := range v.coerceValue(value, typ) {
+		errs = append(errs, valueNodeError{node: valueNode(node, err.path), message: err.message})
+	}
+	return errs
+}
+
+// valueNode returns the node of the value at path within value, or the
+// object field if path leads to one.
+func valueNode(value ast.Value, path []int32) ast.Node {
+	for len(path) >= 3 {
+		switch def := value.(type) {
+		case *ast.ListValue:
+			value, path = def.Values[path[2]], path[3:]
+		case *ast.ObjectValue:
+			field := def.Fields[path[2]]
+			if len(path) == 3 {
+				return field
+			}
+			value, path = field.Value, path[4:]
+		default:
+			return value
+		}
+	}
+	return value
+}
+
+// astType returns the AST of a graphql-go type reference.
+func astType(typ graphql.Type) ast.Type {
+	switch t := typ.(type) {
+	case *graphql.NonNull:
+		return ast.NewNonNull(&ast.NonNull{Type: astType(t.OfType)})
+	case *graphql.List:
+		return ast.NewList(&ast.List{Type: astType(t.OfType)})
+	}
+	return ast.NewNamed(&ast.Named{Name: ast.NewName(&ast.Name{Value: typ.Name()})})
+}
+
+func hasExecutableLocation(def *directiveDefinition, location graphqlc.ExecutableDirectiveLocation) bool {
+	for _, l := range def.Locations {
+		if l, ok := l.Location.(*graphqlc.DirectiveLocationDescriptorProto_ExecutableLocation); ok && l.ExecutableLocation == location {
+			return true
+		}
+	}
+	return false
+}
+
+func reportValidationError(context *graphql.ValidationContext, message string, nodes []ast.Node) {
+	context.ReportError(gqlerrors.NewError(message, nodes, "", nil, []int{}, nil))
+}
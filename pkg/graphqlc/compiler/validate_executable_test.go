@@ -0,0 +1,72 @@
+package compiler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateOperations(t *testing.T) {
+	const schema = "type Query { a: Int b(x: Int!): String q: Query }\n"
+	tests := []struct {
+		name     string
+		document string
+		want     []string
+	}{
+		{
+			name:     "valid",
+			document: "query Q($x: Int!) { a b(x: $x) ...F } fragment F on Query { q { a } }",
+		},
+		{
+			name:     "fragment spread within itself",
+			document: "{ ...F } fragment F on Query { ...F }",
+			want:     []string{`query.graphql:2:32: Cannot spread fragment "F" within itself`},
+		},
+		{
+			name:     "fragment cycle",
+			document: "{ ...F } fragment F on Query { q { ...G } } fragment G on Query { a ...F }",
+			want: []string{
+				`query.graphql:2:36: Cannot spread fragment "F" within itself via G`,
+				`query.graphql:2:69: note: related location`,
+			},
+		},
+		{
+			name:     "unknown field",
+			document: "{ unknown }",
+			want: []string{
+				`query.graphql:2:3: Cannot query field "unknown" on type "Query"`,
+				`query.graphql:1:1: note: object "Query" is defined here`,
+			},
+		},
+		{
+			name:     "conflicting fields",
+			document: "{ x: a x: q { a } }",
+			want: []string{
+				`query.graphql:2:3: Fields "x" conflict because a and q are different fields. Use different aliases on the fields to fetch both if this was intentional`,
+				`query.graphql:2:8: note: related location`,
+				`query.graphql:1:14: note: field Query.a is defined here`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, map[string]string{"query.graphql": schema + tt.document})
+			if got := diagnosticStrings(g.operationDiagnostics()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOperationsWithoutQuery(t *testing.T) {
+	g := buildTestGenerator(t, map[string]string{"types.graphql": "type A { a: Int }\nfragment F on A { a }\n"})
+	if diags := g.operationDiagnostics(); len(diags) > 0 {
+		t.Errorf("got diagnostics %q, want none", diagnosticStrings(diags))
+	}
+	fd, ok := findFile(g.files, "types.graphql")
+	if !ok {
+		t.Fatal("types.graphql: file not found")
+	}
+	if len(fd.Fragments) != 1 || fd.Fragments[0].Name != "F" {
+		t.Errorf("got fragments %v, want F", fd.Fragments)
+	}
+}
@@ -0,0 +1,164 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// writeTestFiles writes files, named relative to a temporary directory,
+// returning the directory.
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "graphqlc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// buildTestGenerator compiles files, named relative to a temporary include
+// path, up to and including BuildTypes.
+func buildTestGenerator(t *testing.T, files map[string]string) *Generator {
+	t.Helper()
+	dir := writeTestFiles(t, files)
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	args := []string{"-I" + dir}
+	for _, name := range names {
+		args = append(args, filepath.Join(dir, name))
+	}
+
+	g := New()
+	g.CommandLineArguments(args)
+	g.BuildTypeMap()
+	g.BuildTypes()
+	return g
+}
+
+// diagnosticStrings formats diags as gcc does.
+func diagnosticStrings(diags []*Diagnostic) []string {
+	var s []string
+	for _, d := range diags {
+		s = append(s, d.Format(ErrorFormatGCC))
+	}
+	return s
+}
+
+func TestValidateTypesRootOperationTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name:  "no Query type",
+			files: map[string]string{"foo.graphql": "type Foo { a: Int }"},
+		},
+		{
+			name: "no Query type in any file",
+			files: map[string]string{
+				"a.graphql": "input A { b: B }",
+				"b.graphql": "enum B { X Y }",
+			},
+		},
+		{
+			name:  "Query type",
+			files: map[string]string{"query.graphql": "type Query { a: Int }"},
+		},
+		{
+			name:  "empty Query type",
+			files: map[string]string{"query.graphql": "type Query"},
+			want:  []string{`query.graphql:1:1: object "Query" must define one or more fields`},
+		},
+		{
+			name:  "query root operation type not an object",
+			files: map[string]string{"schema.graphql": "schema { query: Q } scalar Q"},
+			want:  []string{`schema.graphql:1:10: the query root operation type "Q" must be an object type`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, tt.files)
+			v := newValidator(g.files, g.schema)
+			v.validate()
+			if got := diagnosticStrings(v.diags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateDirectiveUses(t *testing.T) {
+	const schema = "directive @auth(role: Role!, scopes: [String!]) on FIELD_DEFINITION\nenum Role { ADMIN USER }\n"
+	tests := []struct {
+		name  string
+		field string
+		want  []string
+	}{
+		{
+			name:  "valid",
+			field: `a: Int @auth(role: ADMIN, scopes: ["read"])`,
+		},
+		{
+			name:  "value coerced to a list",
+			field: `a: Int @auth(role: ADMIN, scopes: "read")`,
+		},
+		{
+			name:  "unknown argument",
+			field: "a: Int @auth(role: ADMIN, level: 1)",
+			want:  []string{`query.graphql:4:29: directive @auth has no argument "level"`},
+		},
+		{
+			name:  "missing required argument",
+			field: "a: Int @auth(scopes: [])",
+			want:  []string{`query.graphql:4:10: directive @auth requires argument "role" of type Role!`},
+		},
+		{
+			name:  "null required argument",
+			field: "a: Int @auth(role: null)",
+			want:  []string{`query.graphql:4:22: argument "role" of directive @auth has an invalid value, expected Role!, found null`},
+		},
+		{
+			name:  "wrong value type",
+			field: `a: Int @auth(role: "ADMIN")`,
+			want:  []string{`query.graphql:4:22: argument "role" of directive @auth has an invalid value, expected Role, found "ADMIN"`},
+		},
+		{
+			name:  "wrong list item type",
+			field: "a: Int @auth(role: USER, scopes: [1])",
+			want:  []string{`query.graphql:4:37: argument "scopes" of directive @auth has an invalid value, expected String, found 1`},
+		},
+		{
+			name:  "argument given twice",
+			field: "a: Int @auth(role: USER, role: ADMIN)",
+			want:  []string{`query.graphql:4:28: argument "role" of directive @auth is given more than once`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := buildTestGenerator(t, map[string]string{"query.graphql": schema + "type Query {\n  " + tt.field + "\n}"})
+			v := newValidator(g.files, g.schema)
+			v.validate()
+			if got := diagnosticStrings(v.diags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got diagnostics %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
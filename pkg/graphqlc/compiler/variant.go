@@ -0,0 +1,269 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// variantsConfig is the file given by --variants_config, e.g.
+//
+//	{
+//	  "variants": [
+//	    {
+//	      "name": "public",
+//	      "remove": [
+//	        {"directive": "internal"},
+//	        {"directive": "visibility", "arguments": {"audience": "PARTNER"}}
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// A variant removes the types, fields, arguments, input fields and enum
+// values using a directive matching any of its predicates.
+type variantsConfig struct {
+	Variants []*variant `json:"variants"`
+}
+
+type variant struct {
+	Name   string                `json:"name"`
+	Remove []*directivePredicate `json:"remove"`
+}
+
+// directivePredicate matches the uses of a directive, only those with the
+// arguments given if any. An argument matches the string, enum value or
+// source text of its value, or of any item of a list.
+type directivePredicate struct {
+	Directive string            `json:"directive"`
+	Arguments map[string]string `json:"arguments"`
+}
+
+func loadVariantsConfig(path string) (*variantsConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := new(variantsConfig)
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return config, nil
+}
+
+func (c *variantsConfig) variant(name string) *variant {
+	for _, v := range c.Variants {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// generateVariants runs every plugin once for each variant given on the
+// command line. Every variant is applied to the files as they are compiled,
+// its removals are validated again, along with the operations and
+// fragments, before the request of the variant is built.
+func (g *Generator) generateVariants() {
+	if g.VariantsConfig == "" {
+		g.Error(fmt.Errorf("--variant requires --variants_config"))
+	}
+	config, err := loadVariantsConfig(g.VariantsConfig)
+	if err != nil {
+		g.Error(err)
+	}
+	var variants []*variant
+	for _, name := range g.Variants {
+		v := config.variant(name)
+		if v == nil {
+			g.Error(fmt.Errorf("%s: undefined variant %q", g.VariantsConfig, name))
+		}
+		variants = append(variants, v)
+	}
+
+	files := append([]*FileDescriptor(nil), g.files...)
+	genFiles := append([]*FileDescriptor(nil), g.genFiles...)
+	descs := make([]*graphqlc.FileDescriptorGraphql, len(files))
+	locations := make([]sourceLocations, len(files))
+	for i, fd := range files {
+		descs[i] = proto.Clone(fd.FileDescriptorGraphql).(*graphqlc.FileDescriptorGraphql)
+		locations[i] = fd.locations
+	}
+	schema := proto.Clone(g.schema).(*graphqlc.SchemaDescriptorProto)
+
+	for _, v := range variants {
+		g.files = append(g.files[:0], files...)
+		g.genFiles = append(g.genFiles[:0], genFiles...)
+		for i, fd := range g.files {
+			fd.FileDescriptorGraphql = proto.Clone(descs[i]).(*graphqlc.FileDescriptorGraphql)
+			fd.locations = locations[i]
+		}
+		g.applyVariant(v)
+		g.rebuildTypes(proto.Clone(schema).(*graphqlc.SchemaDescriptorProto))
+		g.generate(v.Name)
+	}
+}
+
+// applyVariant removes the definitions matched by a variant from every file.
+func (g *Generator) applyVariant(v *variant) {
+	removed := make(map[string]bool)
+	for _, fd := range g.files {
+		if fd.Name == builtinFileName {
+			continue
+		}
+		rm := make(removals)
+		for _, desc := range fd.Scalars {
+			v.removeType(removed, desc.Name, desc.Directives)
+		}
+		for i, desc := range fd.Objects {
+			v.removeType(removed, desc.Name, desc.Directives)
+			desc.Fields = v.fields(rm, []int32{fileObjectsField, int32(i), objectFieldsField}, desc.Fields)
+		}
+		for i, desc := range fd.Interfaces {
+			v.removeType(removed, desc.Name, desc.Directives)
+			desc.Fields = v.fields(rm, []int32{fileInterfacesField, int32(i), interfaceFieldsField}, desc.Fields)
+		}
+		for _, desc := range fd.Unions {
+			v.removeType(removed, desc.Name, desc.Directives)
+		}
+		for i, desc := range fd.Enums {
+			v.removeType(removed, desc.Name, desc.Directives)
+			desc.Values = v.enumValues(rm, []int32{fileEnumsField, int32(i), enumValuesField}, desc.Values)
+		}
+		for i, desc := range fd.InputObjects {
+			v.removeType(removed, desc.Name, desc.Directives)
+			desc.Fields = v.inputValues(rm, []int32{fileInputObjectsField, int32(i), inputObjectFieldsField}, desc.Fields)
+		}
+		for i, desc := range fd.Directives {
+			desc.Arguments = v.inputValues(rm, []int32{fileDirectivesField, int32(i), directiveDefinitionArgumentsField}, desc.Arguments)
+		}
+		for i, ext := range fd.TypeExtensions {
+			path := []int32{fileTypeExtensionsField, int32(i), typeSystemExtensionTypeField}
+			switch typeExt := ext.GetTypeExtension().GetTypeExtension().(type) {
+			case *graphqlc.TypeExtensionDescriptorProto_ScalarTypeExtension:
+				desc := typeExt.ScalarTypeExtension
+				v.removeType(removed, desc.Name, desc.Directives)
+			case *graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension:
+				desc := typeExt.ObjectTypeExtension
+				v.removeType(removed, desc.Name, desc.Directives)
+				desc.Fields = v.fields(rm, child(path, typeExtensionObjectField, objectFieldsField), desc.Fields)
+			case *graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension:
+				desc := typeExt.InterfaceTypeExtension
+				v.removeType(removed, desc.Name, desc.Directives)
+				desc.Fields = v.fields(rm, child(path, typeExtensionInterfaceField, extensionMembersField), desc.Fields)
+			case *graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension:
+				desc := typeExt.UnionTypeExtension
+				v.removeType(removed, desc.Name, desc.Directives)
+			case *graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions:
+				desc := typeExt.EnumTypeExtions
+				v.removeType(removed, desc.Name, desc.Directives)
+				desc.Values = v.enumValues(rm, child(path, typeExtensionEnumField, extensionMembersField), desc.Values)
+			case *graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension:
+				desc := typeExt.InputObjectTypeExtension
+				v.removeType(removed, desc.Name, desc.Directives)
+				desc.Fields = v.inputValues(rm, child(path, typeExtensionInputObjectField, extensionMembersField), desc.Fields)
+			}
+		}
+		rm.apply(fd)
+	}
+
+	for _, fd := range g.files {
+		if fd.Name != builtinFileName {
+			g.removeTypes(fd, func(name string) bool { return removed[name] })
+		}
+	}
+}
+
+// removeType records the type named name as removed if any of its
+// directives match.
+func (v *variant) removeType(removed map[string]bool, name string, directives []*graphqlc.DirectiveDescriptorProto) {
+	if v.matches(directives) {
+		removed[name] = true
+	}
+}
+
+// fields removes the fields, and the arguments of the fields, matched by
+// the variant from the fields at path.
+func (v *variant) fields(rm removals, path []int32, descs []*graphqlc.FieldDefinitionDescriptorProto) []*graphqlc.FieldDefinitionDescriptorProto {
+	kept := descs[:0]
+	for i, desc := range descs {
+		fieldPath := child(path, int32(i))
+		if v.matches(desc.Directives) {
+			rm.add(fieldPath)
+			continue
+		}
+		desc.Arguments = v.inputValues(rm, child(fieldPath, fieldArgumentsField), desc.Arguments)
+		kept = append(kept, desc)
+	}
+	return kept
+}
+
+func (v *variant) inputValues(rm removals, path []int32, descs []*graphqlc.InputValueDefinitionDescriptorProto) []*graphqlc.InputValueDefinitionDescriptorProto {
+	kept := descs[:0]
+	for i, desc := range descs {
+		if v.matches(desc.Directives) {
+			rm.add(child(path, int32(i)))
+			continue
+		}
+		kept = append(kept, desc)
+	}
+	return kept
+}
+
+func (v *variant) enumValues(rm removals, path []int32, descs []*graphqlc.EnumValueDefinitionDescription) []*graphqlc.EnumValueDefinitionDescription {
+	kept := descs[:0]
+	for i, desc := range descs {
+		if v.matches(desc.Directives) {
+			rm.add(child(path, int32(i)))
+			continue
+		}
+		kept = append(kept, desc)
+	}
+	return kept
+}
+
+// matches reports whether any of the directives matches a predicate of the
+// variant.
+func (v *variant) matches(directives []*graphqlc.DirectiveDescriptorProto) bool {
+	for _, p := range v.Remove {
+		for _, desc := range directives {
+			if p.matches(desc) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *directivePredicate) matches(desc *graphqlc.DirectiveDescriptorProto) bool {
+	if desc.Name != p.Directive {
+		return false
+	}
+	for name, want := range p.Arguments {
+		arg := directiveArgument(desc, name)
+		if arg == nil || !valueMatches(arg.Value, want) {
+			return false
+		}
+	}
+	return true
+}
+
+func valueMatches(value *graphqlc.ValueDescriptorProto, want string) bool {
+	switch val := value.GetValue().(type) {
+	case *graphqlc.ValueDescriptorProto_StringValue:
+		return val.StringValue == want
+	case *graphqlc.ValueDescriptorProto_EnumValue:
+		return val.EnumValue.Value == want
+	case *graphqlc.ValueDescriptorProto_ListValue:
+		for _, item := range val.ListValue.Values {
+			if valueMatches(item, want) {
+				return true
+			}
+		}
+		return false
+	}
+	return value.GetRaw() == want
+}
@@ -0,0 +1,237 @@
+package compiler
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+const variantSchema = `directive @internal on OBJECT | FIELD_DEFINITION | ARGUMENT_DEFINITION | ENUM_VALUE
+directive @visibility(audience: [Audience!]!) on FIELD_DEFINITION
+enum Audience { PARTNER PUBLIC }
+type Query {
+  user(id: ID!, debug: Boolean @internal): User
+  secret: Secret @internal
+  stats: Int @visibility(audience: [PARTNER])
+}
+type User { id: ID! role: Role }
+type Secret @internal { token: String }
+enum Role { ADMIN @internal USER }
+`
+
+const variantsJSON = `{
+  "variants": [
+    {"name": "public", "remove": [{"directive": "internal"}, {"directive": "visibility", "arguments": {"audience": "PARTNER"}}]},
+    {"name": "partner", "remove": [{"directive": "internal"}]}
+  ]
+}`
+
+func TestApplyVariant(t *testing.T) {
+	tests := []struct {
+		variant    string
+		wantFields []string
+		wantArgs   []string
+		wantValues []string
+	}{
+		{
+			variant:    "public",
+			wantFields: []string{"user"},
+			wantArgs:   []string{"id"},
+			wantValues: []string{"USER"},
+		},
+		{
+			variant:    "partner",
+			wantFields: []string{"user", "stats"},
+			wantArgs:   []string{"id"},
+			wantValues: []string{"USER"},
+		},
+	}
+	config, err := loadVariantsConfig(writeTestConfig(t, "variants.json", variantsJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.variant, func(t *testing.T) {
+			g := buildTestGenerator(t, map[string]string{"schema.graphql": variantSchema})
+			g.applyVariant(config.variant(tt.variant))
+			g.buildRequest()
+			r := graphqlc.NewResolver(g.Request)
+
+			var fields, args, values []string
+			for _, f := range r.Object("Query").Fields {
+				fields = append(fields, f.Name)
+			}
+			for _, arg := range r.Object("Query").Fields[0].Arguments {
+				args = append(args, arg.Name)
+			}
+			for _, value := range r.Enum("Role").Values {
+				values = append(values, value.Value)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("got Query fields %v, want %v", fields, tt.wantFields)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("got Query.user arguments %v, want %v", args, tt.wantArgs)
+			}
+			if !reflect.DeepEqual(values, tt.wantValues) {
+				t.Errorf("got Role values %v, want %v", values, tt.wantValues)
+			}
+			if r.Type("Secret") != nil {
+				t.Errorf("object Secret was not removed")
+			}
+		})
+	}
+}
+
+func TestLoadVariantsConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string // prefix of the error, following the path
+	}{
+		{
+			name:    "syntax error",
+			config:  `{"variants": [`,
+			wantErr: "unexpected end of JSON input",
+		},
+		{
+			name:    "predicates not a list",
+			config:  `{"variants": [{"name": "public", "remove": {}}]}`,
+			wantErr: "json: cannot unmarshal object into Go struct field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestConfig(t, "variants.json", tt.config)
+			_, err := loadVariantsConfig(path)
+			if err == nil || !strings.HasPrefix(err.Error(), path+": "+tt.wantErr) {
+				t.Errorf("got error %v, want %s: %s", err, path, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGenerateVariants writes the descriptor set of each variant, and runs
+// a plugin writing out.txt for each, checking every variant is written to a
+// directory of its own.
+func TestGenerateVariants(t *testing.T) {
+	dir := filepath.Dir(writeTestConfig(t, "variants.json", variantsJSON))
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(variantSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := proto.Marshal(&graphqlc.CodeGeneratorResponse{
+		File: []*graphqlc.CodeGeneratorResponse_File{{Name: "out.txt", Content: "generated\n"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "response.pb"), resp, 0644); err != nil {
+		t.Fatal(err)
+	}
+	plugin := "#!/bin/sh\ncat >/dev/null\ncat " + filepath.Join(dir, "response.pb") + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "graphqlc-gen-varianttest"), []byte(plugin), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	t.Cleanup(func() { os.Setenv("PATH", path) })
+
+	out := filepath.Join(dir, "out")
+	g := New()
+	g.CommandLineArguments([]string{
+		"-I" + dir,
+		"--variant=public",
+		"--variant=partner",
+		"--variants_config=" + filepath.Join(dir, "variants.json"),
+		"--descriptor_set_out=" + filepath.Join(out, "schema.pb"),
+		"--varianttest_out=" + filepath.Join(out, "gen"),
+		schema,
+	})
+	if err := os.MkdirAll(filepath.Join(out, "gen"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g.BuildTypeMap()
+	g.BuildTypes()
+	g.ValidateTypes()
+	g.ValidateOperations()
+	g.GenerateAllFiles()
+
+	for variant, want := range map[string][]string{"public": {"user"}, "partner": {"user", "stats"}} {
+		data, err := ioutil.ReadFile(filepath.Join(out, variant, "schema.pb"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		set := new(graphqlc.FileDescriptorSet)
+		if err := proto.Unmarshal(data, set); err != nil {
+			t.Fatal(err)
+		}
+		var fields []string
+		for _, f := range set.File[0].Objects[0].Fields {
+			fields = append(fields, f.Name)
+		}
+		if !reflect.DeepEqual(fields, want) {
+			t.Errorf("%s: got Query fields %v, want %v", variant, fields, want)
+		}
+		if _, err := os.Stat(filepath.Join(out, "gen", variant, "out.txt")); err != nil {
+			t.Errorf("%s: %v", variant, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, "schema.pb")); !os.IsNotExist(err) {
+		t.Errorf("got %s written, want only the sets of the variants", filepath.Join(out, "schema.pb"))
+	}
+}
+
+// TestGenerateVariantsErrors runs itself again for each case, an error
+// exits the program.
+func TestGenerateVariantsErrors(t *testing.T) {
+	if args := os.Getenv("GRAPHQLC_TEST_VARIANTS_ARGS"); args != "" {
+		dir := filepath.Dir(writeTestConfig(t, "variants.json", variantsJSON))
+		g := buildTestGenerator(t, map[string]string{"schema.graphql": variantSchema})
+		g.CommandLineArguments(strings.Split(strings.Replace(args, "DIR", dir, -1), " "))
+		g.generateVariants()
+		return
+	}
+
+	tests := []struct {
+		name string
+		args string
+		want string
+	}{
+		{
+			name: "no variants config",
+			args: "--variant=public",
+			want: "--variant requires --variants_config",
+		},
+		{
+			name: "undefined variant",
+			args: "--variant=internal --variants_config=DIR/variants.json",
+			want: `variants.json: undefined variant "internal"`,
+		},
+		{
+			name: "missing variants config",
+			args: "--variant=public --variants_config=DIR/missing.json",
+			want: "missing.json: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0], "-test.run=^TestGenerateVariantsErrors$")
+			cmd.Env = append(os.Environ(), "GRAPHQLC_TEST_VARIANTS_ARGS="+tt.args)
+			out, err := cmd.CombinedOutput()
+			if _, ok := err.(*exec.ExitError); !ok {
+				t.Fatalf("got error %v, want exit status 1, output:\n%s", err, out)
+			}
+			if !strings.Contains(string(out), tt.want) {
+				t.Errorf("got output\n%s\nwant %s", out, tt.want)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ const (
 	ExecutableDirectiveLocation_FRAGMENT_DEFINITION ExecutableDirectiveLocation = 4
 	ExecutableDirectiveLocation_FRAGMENT_SPREAD     ExecutableDirectiveLocation = 5
 	ExecutableDirectiveLocation_INLINE_FRAGMENT     ExecutableDirectiveLocation = 6
+	ExecutableDirectiveLocation_VARIABLE_DEFINITION ExecutableDirectiveLocation = 7
 )
 
 // Enum value maps for ExecutableDirectiveLocation.
@@ -47,6 +48,7 @@ var (
 		4: "FRAGMENT_DEFINITION",
 		5: "FRAGMENT_SPREAD",
 		6: "INLINE_FRAGMENT",
+		7: "VARIABLE_DEFINITION",
 	}
 	ExecutableDirectiveLocation_value = map[string]int32{
 		"QUERY":               0,
@@ -56,6 +58,7 @@ var (
 		"FRAGMENT_DEFINITION": 4,
 		"FRAGMENT_SPREAD":     5,
 		"INLINE_FRAGMENT":     6,
+		"VARIABLE_DEFINITION": 7,
 	}
 )
 
@@ -159,6 +162,116 @@ func (TypeSystemDirectiveLocation) EnumDescriptor() ([]byte, []int) {
 	return file_descriptor_proto_rawDescGZIP(), []int{1}
 }
 
+type OperationDescriptorProto_Operation int32
+
+const (
+	OperationDescriptorProto_QUERY        OperationDescriptorProto_Operation = 0
+	OperationDescriptorProto_MUTATION     OperationDescriptorProto_Operation = 1
+	OperationDescriptorProto_SUBSCRIPTION OperationDescriptorProto_Operation = 2
+)
+
+// Enum value maps for OperationDescriptorProto_Operation.
+var (
+	OperationDescriptorProto_Operation_name = map[int32]string{
+		0: "QUERY",
+		1: "MUTATION",
+		2: "SUBSCRIPTION",
+	}
+	OperationDescriptorProto_Operation_value = map[string]int32{
+		"QUERY":        0,
+		"MUTATION":     1,
+		"SUBSCRIPTION": 2,
+	}
+)
+
+func (x OperationDescriptorProto_Operation) Enum() *OperationDescriptorProto_Operation {
+	p := new(OperationDescriptorProto_Operation)
+	*p = x
+	return p
+}
+
+func (x OperationDescriptorProto_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OperationDescriptorProto_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_descriptor_proto_enumTypes[2].Descriptor()
+}
+
+func (OperationDescriptorProto_Operation) Type() protoreflect.EnumType {
+	return &file_descriptor_proto_enumTypes[2]
+}
+
+func (x OperationDescriptorProto_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OperationDescriptorProto_Operation.Descriptor instead.
+func (OperationDescriptorProto_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type NamedTypeDescriptorProto_Kind int32
+
+const (
+	NamedTypeDescriptorProto_UNRESOLVED   NamedTypeDescriptorProto_Kind = 0 // the name is not defined by any file
+	NamedTypeDescriptorProto_SCALAR       NamedTypeDescriptorProto_Kind = 1
+	NamedTypeDescriptorProto_OBJECT       NamedTypeDescriptorProto_Kind = 2
+	NamedTypeDescriptorProto_INTERFACE    NamedTypeDescriptorProto_Kind = 3
+	NamedTypeDescriptorProto_UNION        NamedTypeDescriptorProto_Kind = 4
+	NamedTypeDescriptorProto_ENUM         NamedTypeDescriptorProto_Kind = 5
+	NamedTypeDescriptorProto_INPUT_OBJECT NamedTypeDescriptorProto_Kind = 6
+)
+
+// Enum value maps for NamedTypeDescriptorProto_Kind.
+var (
+	NamedTypeDescriptorProto_Kind_name = map[int32]string{
+		0: "UNRESOLVED",
+		1: "SCALAR",
+		2: "OBJECT",
+		3: "INTERFACE",
+		4: "UNION",
+		5: "ENUM",
+		6: "INPUT_OBJECT",
+	}
+	NamedTypeDescriptorProto_Kind_value = map[string]int32{
+		"UNRESOLVED":   0,
+		"SCALAR":       1,
+		"OBJECT":       2,
+		"INTERFACE":    3,
+		"UNION":        4,
+		"ENUM":         5,
+		"INPUT_OBJECT": 6,
+	}
+)
+
+func (x NamedTypeDescriptorProto_Kind) Enum() *NamedTypeDescriptorProto_Kind {
+	p := new(NamedTypeDescriptorProto_Kind)
+	*p = x
+	return p
+}
+
+func (x NamedTypeDescriptorProto_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamedTypeDescriptorProto_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_descriptor_proto_enumTypes[3].Descriptor()
+}
+
+func (NamedTypeDescriptorProto_Kind) Type() protoreflect.EnumType {
+	return &file_descriptor_proto_enumTypes[3]
+}
+
+func (x NamedTypeDescriptorProto_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamedTypeDescriptorProto_Kind.Descriptor instead.
+func (NamedTypeDescriptorProto_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{33, 0}
+}
+
 // The protocol compiler can output a FileDescriptorSet containing the
 // .graphql file it parses.
 type FileDescriptorSet struct {
@@ -215,6 +328,8 @@ type FileDescriptorGraphql struct {
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // file name, relative to root of source tree
+	// Names of files imported by this file.
+	Dependency []string `protobuf:"bytes,11,rep,name=dependency,proto3" json:"dependency,omitempty"`
 	// All top-level definitions in this file.
 	Schema         *SchemaDescriptorProto                      `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	TypeExtensions []*TypeSystemExtensionDescriptorProto       `protobuf:"bytes,10,rep,name=type_extensions,json=typeExtensions,proto3" json:"type_extensions,omitempty"`
@@ -225,6 +340,12 @@ type FileDescriptorGraphql struct {
 	Unions         []*UnionTypeDefinitionDescriptorProto       `protobuf:"bytes,6,rep,name=unions,proto3" json:"unions,omitempty"`
 	Enums          []*EnumTypeDefinitionDescriptorProto        `protobuf:"bytes,7,rep,name=enums,proto3" json:"enums,omitempty"`
 	InputObjects   []*InputObjectTypeDefinitionDescriptorProto `protobuf:"bytes,8,rep,name=input_objects,json=inputObjects,proto3" json:"input_objects,omitempty"`
+	// All executable definitions in this file.
+	Operations []*OperationDescriptorProto `protobuf:"bytes,13,rep,name=operations,proto3" json:"operations,omitempty"`
+	Fragments  []*FragmentDescriptorProto  `protobuf:"bytes,14,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	// Where each descriptor of this file is defined and the comments
+	// around it. Plugins may use it to report positions or copy comments.
+	SourceCodeInfo *SourceCodeInfo `protobuf:"bytes,12,opt,name=source_code_info,json=sourceCodeInfo,proto3" json:"source_code_info,omitempty"`
 }
 
 func (x *FileDescriptorGraphql) Reset() {
@@ -266,6 +387,13 @@ func (x *FileDescriptorGraphql) GetName() string {
 	return ""
 }
 
+func (x *FileDescriptorGraphql) GetDependency() []string {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
 func (x *FileDescriptorGraphql) GetSchema() *SchemaDescriptorProto {
 	if x != nil {
 		return x.Schema
@@ -277,54 +405,709 @@ func (x *FileDescriptorGraphql) GetTypeExtensions() []*TypeSystemExtensionDescri
 	if x != nil {
 		return x.TypeExtensions
 	}
-	return nil
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetDirectives() []*DirectiveDefinitionDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetScalars() []*ScalarTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.Scalars
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetObjects() []*ObjectTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetInterfaces() []*InterfaceTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetUnions() []*UnionTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.Unions
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetEnums() []*EnumTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetInputObjects() []*InputObjectTypeDefinitionDescriptorProto {
+	if x != nil {
+		return x.InputObjects
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetOperations() []*OperationDescriptorProto {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetFragments() []*FragmentDescriptorProto {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+func (x *FileDescriptorGraphql) GetSourceCodeInfo() *SourceCodeInfo {
+	if x != nil {
+		return x.SourceCodeInfo
+	}
+	return nil
+}
+
+// Encapsulates information about the original source file from which a
+// FileDescriptorGraphql was generated, as protobuf's SourceCodeInfo does.
+type SourceCodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A Location identifies a piece of source code in a .graphql file which
+	// corresponds to a particular definition.
+	Location []*SourceCodeInfo_Location `protobuf:"bytes,1,rep,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *SourceCodeInfo) Reset() {
+	*x = SourceCodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceCodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceCodeInfo) ProtoMessage() {}
+
+func (x *SourceCodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceCodeInfo.ProtoReflect.Descriptor instead.
+func (*SourceCodeInfo) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SourceCodeInfo) GetLocation() []*SourceCodeInfo_Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type OperationDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation           OperationDescriptorProto_Operation   `protobuf:"varint,1,opt,name=operation,proto3,enum=graphqlc.OperationDescriptorProto_Operation" json:"operation,omitempty"`
+	Name                string                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // empty for anonymous operations
+	VariableDefinitions []*VariableDefinitionDescriptorProto `protobuf:"bytes,3,rep,name=variable_definitions,json=variableDefinitions,proto3" json:"variable_definitions,omitempty"`
+	Directives          []*DirectiveDescriptorProto          `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	// Selections on the root operation type of the schema.
+	SelectionSet *SelectionSetDescriptorProto `protobuf:"bytes,5,opt,name=selection_set,json=selectionSet,proto3" json:"selection_set,omitempty"`
+}
+
+func (x *OperationDescriptorProto) Reset() {
+	*x = OperationDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationDescriptorProto) ProtoMessage() {}
+
+func (x *OperationDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationDescriptorProto.ProtoReflect.Descriptor instead.
+func (*OperationDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OperationDescriptorProto) GetOperation() OperationDescriptorProto_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return OperationDescriptorProto_QUERY
+}
+
+func (x *OperationDescriptorProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationDescriptorProto) GetVariableDefinitions() []*VariableDefinitionDescriptorProto {
+	if x != nil {
+		return x.VariableDefinitions
+	}
+	return nil
+}
+
+func (x *OperationDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+func (x *OperationDescriptorProto) GetSelectionSet() *SelectionSetDescriptorProto {
+	if x != nil {
+		return x.SelectionSet
+	}
+	return nil
+}
+
+type VariableDefinitionDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // without the leading $
+	Type         *TypeDescriptorProto        `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue *ValueDescriptorProto       `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Directives   []*DirectiveDescriptorProto `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+}
+
+func (x *VariableDefinitionDescriptorProto) Reset() {
+	*x = VariableDefinitionDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariableDefinitionDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableDefinitionDescriptorProto) ProtoMessage() {}
+
+func (x *VariableDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
+func (*VariableDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VariableDefinitionDescriptorProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableDefinitionDescriptorProto) GetType() *TypeDescriptorProto {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *VariableDefinitionDescriptorProto) GetDefaultValue() *ValueDescriptorProto {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *VariableDefinitionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+type FragmentDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TypeCondition *NamedTypeDescriptorProto    `protobuf:"bytes,2,opt,name=type_condition,json=typeCondition,proto3" json:"type_condition,omitempty"`
+	Directives    []*DirectiveDescriptorProto  `protobuf:"bytes,3,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet  *SelectionSetDescriptorProto `protobuf:"bytes,4,opt,name=selection_set,json=selectionSet,proto3" json:"selection_set,omitempty"`
+}
+
+func (x *FragmentDescriptorProto) Reset() {
+	*x = FragmentDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FragmentDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FragmentDescriptorProto) ProtoMessage() {}
+
+func (x *FragmentDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FragmentDescriptorProto.ProtoReflect.Descriptor instead.
+func (*FragmentDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FragmentDescriptorProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FragmentDescriptorProto) GetTypeCondition() *NamedTypeDescriptorProto {
+	if x != nil {
+		return x.TypeCondition
+	}
+	return nil
+}
+
+func (x *FragmentDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+func (x *FragmentDescriptorProto) GetSelectionSet() *SelectionSetDescriptorProto {
+	if x != nil {
+		return x.SelectionSet
+	}
+	return nil
+}
+
+// Selections on a type of the schema.
+type SelectionSetDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type selected from. Unset if it could not be resolved, e.g. the
+	// selections of an undefined field.
+	Type       *NamedTypeDescriptorProto   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Selections []*SelectionDescriptorProto `protobuf:"bytes,2,rep,name=selections,proto3" json:"selections,omitempty"`
+}
+
+func (x *SelectionSetDescriptorProto) Reset() {
+	*x = SelectionSetDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectionSetDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectionSetDescriptorProto) ProtoMessage() {}
+
+func (x *SelectionSetDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectionSetDescriptorProto.ProtoReflect.Descriptor instead.
+func (*SelectionSetDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SelectionSetDescriptorProto) GetType() *NamedTypeDescriptorProto {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SelectionSetDescriptorProto) GetSelections() []*SelectionDescriptorProto {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+type SelectionDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Selection:
+	//	*SelectionDescriptorProto_Field
+	//	*SelectionDescriptorProto_FragmentSpread
+	//	*SelectionDescriptorProto_InlineFragment
+	Selection isSelectionDescriptorProto_Selection `protobuf_oneof:"selection"`
+}
+
+func (x *SelectionDescriptorProto) Reset() {
+	*x = SelectionDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectionDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectionDescriptorProto) ProtoMessage() {}
+
+func (x *SelectionDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectionDescriptorProto.ProtoReflect.Descriptor instead.
+func (*SelectionDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *SelectionDescriptorProto) GetSelection() isSelectionDescriptorProto_Selection {
+	if m != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (x *SelectionDescriptorProto) GetField() *FieldSelectionDescriptorProto {
+	if x, ok := x.GetSelection().(*SelectionDescriptorProto_Field); ok {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *SelectionDescriptorProto) GetFragmentSpread() *FragmentSpreadDescriptorProto {
+	if x, ok := x.GetSelection().(*SelectionDescriptorProto_FragmentSpread); ok {
+		return x.FragmentSpread
+	}
+	return nil
+}
+
+func (x *SelectionDescriptorProto) GetInlineFragment() *InlineFragmentDescriptorProto {
+	if x, ok := x.GetSelection().(*SelectionDescriptorProto_InlineFragment); ok {
+		return x.InlineFragment
+	}
+	return nil
+}
+
+type isSelectionDescriptorProto_Selection interface {
+	isSelectionDescriptorProto_Selection()
+}
+
+type SelectionDescriptorProto_Field struct {
+	Field *FieldSelectionDescriptorProto `protobuf:"bytes,1,opt,name=field,proto3,oneof"`
+}
+
+type SelectionDescriptorProto_FragmentSpread struct {
+	FragmentSpread *FragmentSpreadDescriptorProto `protobuf:"bytes,2,opt,name=fragment_spread,json=fragmentSpread,proto3,oneof"`
+}
+
+type SelectionDescriptorProto_InlineFragment struct {
+	InlineFragment *InlineFragmentDescriptorProto `protobuf:"bytes,3,opt,name=inline_fragment,json=inlineFragment,proto3,oneof"`
+}
+
+func (*SelectionDescriptorProto_Field) isSelectionDescriptorProto_Selection() {}
+
+func (*SelectionDescriptorProto_FragmentSpread) isSelectionDescriptorProto_Selection() {}
+
+func (*SelectionDescriptorProto_InlineFragment) isSelectionDescriptorProto_Selection() {}
+
+type FieldSelectionDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias      string                      `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"` // empty if the field is not aliased
+	Name       string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Arguments  []*ArgumentDescriptorProto  `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Directives []*DirectiveDescriptorProto `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	// Set if the field's type is an object, interface or union.
+	SelectionSet *SelectionSetDescriptorProto `protobuf:"bytes,5,opt,name=selection_set,json=selectionSet,proto3" json:"selection_set,omitempty"`
+	// The type selected from, its field named name is the field of the
+	// schema selected, see Resolver.Field. Unset if the type has no such
+	// field.
+	ParentType *NamedTypeDescriptorProto `protobuf:"bytes,7,opt,name=parent_type,json=parentType,proto3" json:"parent_type,omitempty"`
+}
+
+func (x *FieldSelectionDescriptorProto) Reset() {
+	*x = FieldSelectionDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldSelectionDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldSelectionDescriptorProto) ProtoMessage() {}
+
+func (x *FieldSelectionDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldSelectionDescriptorProto.ProtoReflect.Descriptor instead.
+func (*FieldSelectionDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FieldSelectionDescriptorProto) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *FieldSelectionDescriptorProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldSelectionDescriptorProto) GetArguments() []*ArgumentDescriptorProto {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *FieldSelectionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+func (x *FieldSelectionDescriptorProto) GetSelectionSet() *SelectionSetDescriptorProto {
+	if x != nil {
+		return x.SelectionSet
+	}
+	return nil
+}
+
+func (x *FieldSelectionDescriptorProto) GetParentType() *NamedTypeDescriptorProto {
+	if x != nil {
+		return x.ParentType
+	}
+	return nil
+}
+
+type FragmentSpreadDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Directives []*DirectiveDescriptorProto `protobuf:"bytes,2,rep,name=directives,proto3" json:"directives,omitempty"`
+}
+
+func (x *FragmentSpreadDescriptorProto) Reset() {
+	*x = FragmentSpreadDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FragmentSpreadDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FragmentSpreadDescriptorProto) ProtoMessage() {}
+
+func (x *FragmentSpreadDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FragmentSpreadDescriptorProto.ProtoReflect.Descriptor instead.
+func (*FragmentSpreadDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FragmentSpreadDescriptorProto) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FragmentSpreadDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+type InlineFragmentDescriptorProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeCondition *NamedTypeDescriptorProto    `protobuf:"bytes,1,opt,name=type_condition,json=typeCondition,proto3" json:"type_condition,omitempty"` // unset if omitted
+	Directives    []*DirectiveDescriptorProto  `protobuf:"bytes,2,rep,name=directives,proto3" json:"directives,omitempty"`
+	SelectionSet  *SelectionSetDescriptorProto `protobuf:"bytes,3,opt,name=selection_set,json=selectionSet,proto3" json:"selection_set,omitempty"`
+}
+
+func (x *InlineFragmentDescriptorProto) Reset() {
+	*x = InlineFragmentDescriptorProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *FileDescriptorGraphql) GetDirectives() []*DirectiveDefinitionDescriptorProto {
-	if x != nil {
-		return x.Directives
-	}
-	return nil
+func (x *InlineFragmentDescriptorProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FileDescriptorGraphql) GetScalars() []*ScalarTypeDefinitionDescriptorProto {
-	if x != nil {
-		return x.Scalars
-	}
-	return nil
-}
+func (*InlineFragmentDescriptorProto) ProtoMessage() {}
 
-func (x *FileDescriptorGraphql) GetObjects() []*ObjectTypeDefinitionDescriptorProto {
-	if x != nil {
-		return x.Objects
+func (x *InlineFragmentDescriptorProto) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FileDescriptorGraphql) GetInterfaces() []*InterfaceTypeDefinitionDescriptorProto {
-	if x != nil {
-		return x.Interfaces
-	}
-	return nil
+// Deprecated: Use InlineFragmentDescriptorProto.ProtoReflect.Descriptor instead.
+func (*InlineFragmentDescriptorProto) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FileDescriptorGraphql) GetUnions() []*UnionTypeDefinitionDescriptorProto {
+func (x *InlineFragmentDescriptorProto) GetTypeCondition() *NamedTypeDescriptorProto {
 	if x != nil {
-		return x.Unions
+		return x.TypeCondition
 	}
 	return nil
 }
 
-func (x *FileDescriptorGraphql) GetEnums() []*EnumTypeDefinitionDescriptorProto {
+func (x *InlineFragmentDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
 	if x != nil {
-		return x.Enums
+		return x.Directives
 	}
 	return nil
 }
 
-func (x *FileDescriptorGraphql) GetInputObjects() []*InputObjectTypeDefinitionDescriptorProto {
+func (x *InlineFragmentDescriptorProto) GetSelectionSet() *SelectionSetDescriptorProto {
 	if x != nil {
-		return x.InputObjects
+		return x.SelectionSet
 	}
 	return nil
 }
@@ -334,16 +1117,18 @@ type SchemaDescriptorProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Directives   []*DirectiveDescriptorProto          `protobuf:"bytes,1,rep,name=directives,proto3" json:"directives,omitempty"`
-	Query        *ObjectTypeDefinitionDescriptorProto `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	Mutation     *ObjectTypeDefinitionDescriptorProto `protobuf:"bytes,3,opt,name=mutation,proto3" json:"mutation,omitempty"`
-	Subscription *ObjectTypeDefinitionDescriptorProto `protobuf:"bytes,4,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Directives  []*DirectiveDescriptorProto `protobuf:"bytes,1,rep,name=directives,proto3" json:"directives,omitempty"`
+	Description string                      `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The root operation types, objects defined in the files of the request.
+	Query        *NamedTypeDescriptorProto `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	Mutation     *NamedTypeDescriptorProto `protobuf:"bytes,7,opt,name=mutation,proto3" json:"mutation,omitempty"`
+	Subscription *NamedTypeDescriptorProto `protobuf:"bytes,8,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
 func (x *SchemaDescriptorProto) Reset() {
 	*x = SchemaDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[2]
+		mi := &file_descriptor_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +1141,7 @@ func (x *SchemaDescriptorProto) String() string {
 func (*SchemaDescriptorProto) ProtoMessage() {}
 
 func (x *SchemaDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[2]
+	mi := &file_descriptor_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +1154,7 @@ func (x *SchemaDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaDescriptorProto.ProtoReflect.Descriptor instead.
 func (*SchemaDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{2}
+	return file_descriptor_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SchemaDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
@@ -379,21 +1164,28 @@ func (x *SchemaDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
 	return nil
 }
 
-func (x *SchemaDescriptorProto) GetQuery() *ObjectTypeDefinitionDescriptorProto {
+func (x *SchemaDescriptorProto) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SchemaDescriptorProto) GetQuery() *NamedTypeDescriptorProto {
 	if x != nil {
 		return x.Query
 	}
 	return nil
 }
 
-func (x *SchemaDescriptorProto) GetMutation() *ObjectTypeDefinitionDescriptorProto {
+func (x *SchemaDescriptorProto) GetMutation() *NamedTypeDescriptorProto {
 	if x != nil {
 		return x.Mutation
 	}
 	return nil
 }
 
-func (x *SchemaDescriptorProto) GetSubscription() *ObjectTypeDefinitionDescriptorProto {
+func (x *SchemaDescriptorProto) GetSubscription() *NamedTypeDescriptorProto {
 	if x != nil {
 		return x.Subscription
 	}
@@ -409,12 +1201,20 @@ type DirectiveDefinitionDescriptorProto struct {
 	Name        string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Arguments   []*InputValueDefinitionDescriptorProto `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
 	Locations   []*DirectiveLocationDescriptorProto    `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty"`
+	// Set for the directives of the GraphQL specification, @skip, @include,
+	// @deprecated and @specifiedBy.
+	Builtin bool `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	// Set if the directive may be used more than once at a location.
+	Repeatable bool `protobuf:"varint,6,opt,name=repeatable,proto3" json:"repeatable,omitempty"`
+	// Set for the directives graphqlc implements itself, such as
+	// @connection, given to plugins in graphqlc/directives.graphql.
+	Synthesized bool `protobuf:"varint,7,opt,name=synthesized,proto3" json:"synthesized,omitempty"`
 }
 
 func (x *DirectiveDefinitionDescriptorProto) Reset() {
 	*x = DirectiveDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[3]
+		mi := &file_descriptor_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +1227,7 @@ func (x *DirectiveDefinitionDescriptorProto) String() string {
 func (*DirectiveDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *DirectiveDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[3]
+	mi := &file_descriptor_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +1240,7 @@ func (x *DirectiveDefinitionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DirectiveDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*DirectiveDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{3}
+	return file_descriptor_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DirectiveDefinitionDescriptorProto) GetDescription() string {
@@ -471,6 +1271,27 @@ func (x *DirectiveDefinitionDescriptorProto) GetLocations() []*DirectiveLocation
 	return nil
 }
 
+func (x *DirectiveDefinitionDescriptorProto) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *DirectiveDefinitionDescriptorProto) GetRepeatable() bool {
+	if x != nil {
+		return x.Repeatable
+	}
+	return false
+}
+
+func (x *DirectiveDefinitionDescriptorProto) GetSynthesized() bool {
+	if x != nil {
+		return x.Synthesized
+	}
+	return false
+}
+
 type DirectiveLocationDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -485,7 +1306,7 @@ type DirectiveLocationDescriptorProto struct {
 func (x *DirectiveLocationDescriptorProto) Reset() {
 	*x = DirectiveLocationDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[4]
+		mi := &file_descriptor_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -498,7 +1319,7 @@ func (x *DirectiveLocationDescriptorProto) String() string {
 func (*DirectiveLocationDescriptorProto) ProtoMessage() {}
 
 func (x *DirectiveLocationDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[4]
+	mi := &file_descriptor_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +1332,7 @@ func (x *DirectiveLocationDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectiveLocationDescriptorProto.ProtoReflect.Descriptor instead.
 func (*DirectiveLocationDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{4}
+	return file_descriptor_proto_rawDescGZIP(), []int{13}
 }
 
 func (m *DirectiveLocationDescriptorProto) GetLocation() isDirectiveLocationDescriptorProto_Location {
@@ -561,12 +1382,17 @@ type ScalarTypeDefinitionDescriptorProto struct {
 	Description string                      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	Name        string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Directives  []*DirectiveDescriptorProto `protobuf:"bytes,3,rep,name=directives,proto3" json:"directives,omitempty"`
+	// Set for the scalars of the GraphQL specification, Int, Float, String,
+	// Boolean and ID.
+	Builtin bool `protobuf:"varint,4,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	// The url argument of the @specifiedBy directive, if the scalar has one.
+	SpecifiedByUrl string `protobuf:"bytes,5,opt,name=specified_by_url,json=specifiedByUrl,proto3" json:"specified_by_url,omitempty"`
 }
 
 func (x *ScalarTypeDefinitionDescriptorProto) Reset() {
 	*x = ScalarTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[5]
+		mi := &file_descriptor_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -579,7 +1405,7 @@ func (x *ScalarTypeDefinitionDescriptorProto) String() string {
 func (*ScalarTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *ScalarTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[5]
+	mi := &file_descriptor_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +1418,7 @@ func (x *ScalarTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ScalarTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ScalarTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{5}
+	return file_descriptor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ScalarTypeDefinitionDescriptorProto) GetDescription() string {
@@ -616,6 +1442,20 @@ func (x *ScalarTypeDefinitionDescriptorProto) GetDirectives() []*DirectiveDescri
 	return nil
 }
 
+func (x *ScalarTypeDefinitionDescriptorProto) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *ScalarTypeDefinitionDescriptorProto) GetSpecifiedByUrl() string {
+	if x != nil {
+		return x.SpecifiedByUrl
+	}
+	return ""
+}
+
 type ScalarTypeExtensionDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -628,7 +1468,7 @@ type ScalarTypeExtensionDescriptorProto struct {
 func (x *ScalarTypeExtensionDescriptorProto) Reset() {
 	*x = ScalarTypeExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[6]
+		mi := &file_descriptor_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +1481,7 @@ func (x *ScalarTypeExtensionDescriptorProto) String() string {
 func (*ScalarTypeExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *ScalarTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[6]
+	mi := &file_descriptor_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1494,7 @@ func (x *ScalarTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ScalarTypeExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ScalarTypeExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{6}
+	return file_descriptor_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ScalarTypeExtensionDescriptorProto) GetName() string {
@@ -676,17 +1516,22 @@ type ObjectTypeDefinitionDescriptorProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description string                                    `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Name        string                                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Implements  []*InterfaceTypeDefinitionDescriptorProto `protobuf:"bytes,3,rep,name=implements,proto3" json:"implements,omitempty"`
-	Directives  []*DirectiveDescriptorProto               `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
-	Fields      []*FieldDefinitionDescriptorProto         `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Description string                            `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string                            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Directives  []*DirectiveDescriptorProto       `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	Fields      []*FieldDefinitionDescriptorProto `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Implements  []*NamedTypeDescriptorProto       `protobuf:"bytes,6,rep,name=implements,proto3" json:"implements,omitempty"`
+	// Set for objects added by graphqlc rather than defined in a file, such
+	// as the Relay connection types of @connection fields. The
+	// SourceCodeInfo of a synthesized object spans the definition it is
+	// derived from.
+	Synthesized bool `protobuf:"varint,7,opt,name=synthesized,proto3" json:"synthesized,omitempty"`
 }
 
 func (x *ObjectTypeDefinitionDescriptorProto) Reset() {
 	*x = ObjectTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[7]
+		mi := &file_descriptor_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +1544,7 @@ func (x *ObjectTypeDefinitionDescriptorProto) String() string {
 func (*ObjectTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *ObjectTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[7]
+	mi := &file_descriptor_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +1557,7 @@ func (x *ObjectTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ObjectTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ObjectTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{7}
+	return file_descriptor_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ObjectTypeDefinitionDescriptorProto) GetDescription() string {
@@ -729,42 +1574,49 @@ func (x *ObjectTypeDefinitionDescriptorProto) GetName() string {
 	return ""
 }
 
-func (x *ObjectTypeDefinitionDescriptorProto) GetImplements() []*InterfaceTypeDefinitionDescriptorProto {
+func (x *ObjectTypeDefinitionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
 	if x != nil {
-		return x.Implements
+		return x.Directives
 	}
 	return nil
 }
 
-func (x *ObjectTypeDefinitionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+func (x *ObjectTypeDefinitionDescriptorProto) GetFields() []*FieldDefinitionDescriptorProto {
 	if x != nil {
-		return x.Directives
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *ObjectTypeDefinitionDescriptorProto) GetFields() []*FieldDefinitionDescriptorProto {
+func (x *ObjectTypeDefinitionDescriptorProto) GetImplements() []*NamedTypeDescriptorProto {
 	if x != nil {
-		return x.Fields
+		return x.Implements
 	}
 	return nil
 }
 
+func (x *ObjectTypeDefinitionDescriptorProto) GetSynthesized() bool {
+	if x != nil {
+		return x.Synthesized
+	}
+	return false
+}
+
 type ObjectTypeExtensionDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string                                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Implements []*InterfaceTypeDefinitionDescriptorProto `protobuf:"bytes,3,rep,name=implements,proto3" json:"implements,omitempty"`
-	Directives []*DirectiveDescriptorProto               `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
-	Fields     []*FieldDefinitionDescriptorProto         `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Name       string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Directives []*DirectiveDescriptorProto       `protobuf:"bytes,4,rep,name=directives,proto3" json:"directives,omitempty"`
+	Fields     []*FieldDefinitionDescriptorProto `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	Implements []*NamedTypeDescriptorProto       `protobuf:"bytes,6,rep,name=implements,proto3" json:"implements,omitempty"`
 }
 
 func (x *ObjectTypeExtensionDescriptorProto) Reset() {
 	*x = ObjectTypeExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[8]
+		mi := &file_descriptor_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1629,7 @@ func (x *ObjectTypeExtensionDescriptorProto) String() string {
 func (*ObjectTypeExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *ObjectTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[8]
+	mi := &file_descriptor_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1642,7 @@ func (x *ObjectTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ObjectTypeExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ObjectTypeExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{8}
+	return file_descriptor_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ObjectTypeExtensionDescriptorProto) GetName() string {
@@ -800,23 +1652,23 @@ func (x *ObjectTypeExtensionDescriptorProto) GetName() string {
 	return ""
 }
 
-func (x *ObjectTypeExtensionDescriptorProto) GetImplements() []*InterfaceTypeDefinitionDescriptorProto {
+func (x *ObjectTypeExtensionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
 	if x != nil {
-		return x.Implements
+		return x.Directives
 	}
 	return nil
 }
 
-func (x *ObjectTypeExtensionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
+func (x *ObjectTypeExtensionDescriptorProto) GetFields() []*FieldDefinitionDescriptorProto {
 	if x != nil {
-		return x.Directives
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *ObjectTypeExtensionDescriptorProto) GetFields() []*FieldDefinitionDescriptorProto {
+func (x *ObjectTypeExtensionDescriptorProto) GetImplements() []*NamedTypeDescriptorProto {
 	if x != nil {
-		return x.Fields
+		return x.Implements
 	}
 	return nil
 }
@@ -830,12 +1682,13 @@ type InterfaceTypeDefinitionDescriptorProto struct {
 	Name        string                            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Directives  []*DirectiveDescriptorProto       `protobuf:"bytes,3,rep,name=directives,proto3" json:"directives,omitempty"`
 	Fields      []*FieldDefinitionDescriptorProto `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Implements  []*NamedTypeDescriptorProto       `protobuf:"bytes,6,rep,name=implements,proto3" json:"implements,omitempty"`
 }
 
 func (x *InterfaceTypeDefinitionDescriptorProto) Reset() {
 	*x = InterfaceTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[9]
+		mi := &file_descriptor_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -848,7 +1701,7 @@ func (x *InterfaceTypeDefinitionDescriptorProto) String() string {
 func (*InterfaceTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *InterfaceTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[9]
+	mi := &file_descriptor_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,7 +1714,7 @@ func (x *InterfaceTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use InterfaceTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*InterfaceTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{9}
+	return file_descriptor_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *InterfaceTypeDefinitionDescriptorProto) GetDescription() string {
@@ -892,6 +1745,13 @@ func (x *InterfaceTypeDefinitionDescriptorProto) GetFields() []*FieldDefinitionD
 	return nil
 }
 
+func (x *InterfaceTypeDefinitionDescriptorProto) GetImplements() []*NamedTypeDescriptorProto {
+	if x != nil {
+		return x.Implements
+	}
+	return nil
+}
+
 type InterfaceTypeExtensionDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -900,12 +1760,13 @@ type InterfaceTypeExtensionDescriptorProto struct {
 	Name       string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Directives []*DirectiveDescriptorProto       `protobuf:"bytes,2,rep,name=directives,proto3" json:"directives,omitempty"`
 	Fields     []*FieldDefinitionDescriptorProto `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Implements []*NamedTypeDescriptorProto       `protobuf:"bytes,5,rep,name=implements,proto3" json:"implements,omitempty"`
 }
 
 func (x *InterfaceTypeExtensionDescriptorProto) Reset() {
 	*x = InterfaceTypeExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[10]
+		mi := &file_descriptor_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -918,7 +1779,7 @@ func (x *InterfaceTypeExtensionDescriptorProto) String() string {
 func (*InterfaceTypeExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *InterfaceTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[10]
+	mi := &file_descriptor_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +1792,7 @@ func (x *InterfaceTypeExtensionDescriptorProto) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use InterfaceTypeExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*InterfaceTypeExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{10}
+	return file_descriptor_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *InterfaceTypeExtensionDescriptorProto) GetName() string {
@@ -955,6 +1816,13 @@ func (x *InterfaceTypeExtensionDescriptorProto) GetFields() []*FieldDefinitionDe
 	return nil
 }
 
+func (x *InterfaceTypeExtensionDescriptorProto) GetImplements() []*NamedTypeDescriptorProto {
+	if x != nil {
+		return x.Implements
+	}
+	return nil
+}
+
 type UnionTypeDefinitionDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -969,7 +1837,7 @@ type UnionTypeDefinitionDescriptorProto struct {
 func (x *UnionTypeDefinitionDescriptorProto) Reset() {
 	*x = UnionTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[11]
+		mi := &file_descriptor_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -982,7 +1850,7 @@ func (x *UnionTypeDefinitionDescriptorProto) String() string {
 func (*UnionTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *UnionTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[11]
+	mi := &file_descriptor_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1863,7 @@ func (x *UnionTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UnionTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*UnionTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{11}
+	return file_descriptor_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UnionTypeDefinitionDescriptorProto) GetDescription() string {
@@ -1039,7 +1907,7 @@ type UnionTypeExtensionDefinitionDescriptorProto struct {
 func (x *UnionTypeExtensionDefinitionDescriptorProto) Reset() {
 	*x = UnionTypeExtensionDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[12]
+		mi := &file_descriptor_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1052,7 +1920,7 @@ func (x *UnionTypeExtensionDefinitionDescriptorProto) String() string {
 func (*UnionTypeExtensionDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *UnionTypeExtensionDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[12]
+	mi := &file_descriptor_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,7 +1933,7 @@ func (x *UnionTypeExtensionDefinitionDescriptorProto) ProtoReflect() protoreflec
 
 // Deprecated: Use UnionTypeExtensionDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*UnionTypeExtensionDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{12}
+	return file_descriptor_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UnionTypeExtensionDefinitionDescriptorProto) GetName() string {
@@ -1103,7 +1971,7 @@ type EnumTypeDefinitionDescriptorProto struct {
 func (x *EnumTypeDefinitionDescriptorProto) Reset() {
 	*x = EnumTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[13]
+		mi := &file_descriptor_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1116,7 +1984,7 @@ func (x *EnumTypeDefinitionDescriptorProto) String() string {
 func (*EnumTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *EnumTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[13]
+	mi := &file_descriptor_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1997,7 @@ func (x *EnumTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use EnumTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*EnumTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{13}
+	return file_descriptor_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *EnumTypeDefinitionDescriptorProto) GetDescription() string {
@@ -1173,7 +2041,7 @@ type EnumTypeExtensionDefinitionDescriptorProto struct {
 func (x *EnumTypeExtensionDefinitionDescriptorProto) Reset() {
 	*x = EnumTypeExtensionDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[14]
+		mi := &file_descriptor_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +2054,7 @@ func (x *EnumTypeExtensionDefinitionDescriptorProto) String() string {
 func (*EnumTypeExtensionDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *EnumTypeExtensionDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[14]
+	mi := &file_descriptor_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +2067,7 @@ func (x *EnumTypeExtensionDefinitionDescriptorProto) ProtoReflect() protoreflect
 
 // Deprecated: Use EnumTypeExtensionDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*EnumTypeExtensionDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{14}
+	return file_descriptor_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *EnumTypeExtensionDefinitionDescriptorProto) GetName() string {
@@ -1237,7 +2105,7 @@ type InputObjectTypeDefinitionDescriptorProto struct {
 func (x *InputObjectTypeDefinitionDescriptorProto) Reset() {
 	*x = InputObjectTypeDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[15]
+		mi := &file_descriptor_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1250,7 +2118,7 @@ func (x *InputObjectTypeDefinitionDescriptorProto) String() string {
 func (*InputObjectTypeDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *InputObjectTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[15]
+	mi := &file_descriptor_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +2131,7 @@ func (x *InputObjectTypeDefinitionDescriptorProto) ProtoReflect() protoreflect.M
 
 // Deprecated: Use InputObjectTypeDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*InputObjectTypeDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{15}
+	return file_descriptor_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *InputObjectTypeDefinitionDescriptorProto) GetDescription() string {
@@ -1307,7 +2175,7 @@ type InputObjectTypeExtensionDefinitionDescriptorProto struct {
 func (x *InputObjectTypeExtensionDefinitionDescriptorProto) Reset() {
 	*x = InputObjectTypeExtensionDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[16]
+		mi := &file_descriptor_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +2188,7 @@ func (x *InputObjectTypeExtensionDefinitionDescriptorProto) String() string {
 func (*InputObjectTypeExtensionDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *InputObjectTypeExtensionDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[16]
+	mi := &file_descriptor_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +2201,7 @@ func (x *InputObjectTypeExtensionDefinitionDescriptorProto) ProtoReflect() proto
 
 // Deprecated: Use InputObjectTypeExtensionDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*InputObjectTypeExtensionDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{16}
+	return file_descriptor_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *InputObjectTypeExtensionDefinitionDescriptorProto) GetName() string {
@@ -1371,7 +2239,7 @@ type TypeSystemExtensionDescriptorProto struct {
 func (x *TypeSystemExtensionDescriptorProto) Reset() {
 	*x = TypeSystemExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[17]
+		mi := &file_descriptor_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1384,7 +2252,7 @@ func (x *TypeSystemExtensionDescriptorProto) String() string {
 func (*TypeSystemExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *TypeSystemExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[17]
+	mi := &file_descriptor_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,7 +2265,7 @@ func (x *TypeSystemExtensionDescriptorProto) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TypeSystemExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*TypeSystemExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{17}
+	return file_descriptor_proto_rawDescGZIP(), []int{26}
 }
 
 func (m *TypeSystemExtensionDescriptorProto) GetExtension() isTypeSystemExtensionDescriptorProto_Extension {
@@ -1444,14 +2312,16 @@ type SchemaExtensionDescriptorProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Directives               []*DirectiveDescriptorProto `protobuf:"bytes,1,rep,name=directives,proto3" json:"directives,omitempty"`
-	OperationTypeDefinitions []*NamedTypeDescriptorProto `protobuf:"bytes,2,rep,name=operation_type_definitions,json=operationTypeDefinitions,proto3" json:"operation_type_definitions,omitempty"`
+	Directives   []*DirectiveDescriptorProto `protobuf:"bytes,1,rep,name=directives,proto3" json:"directives,omitempty"`
+	Query        *NamedTypeDescriptorProto   `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	Mutation     *NamedTypeDescriptorProto   `protobuf:"bytes,7,opt,name=mutation,proto3" json:"mutation,omitempty"`
+	Subscription *NamedTypeDescriptorProto   `protobuf:"bytes,8,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
 func (x *SchemaExtensionDescriptorProto) Reset() {
 	*x = SchemaExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[18]
+		mi := &file_descriptor_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +2334,7 @@ func (x *SchemaExtensionDescriptorProto) String() string {
 func (*SchemaExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *SchemaExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[18]
+	mi := &file_descriptor_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +2347,7 @@ func (x *SchemaExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchemaExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*SchemaExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{18}
+	return file_descriptor_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SchemaExtensionDescriptorProto) GetDirectives() []*DirectiveDescriptorProto {
@@ -1487,9 +2357,23 @@ func (x *SchemaExtensionDescriptorProto) GetDirectives() []*DirectiveDescriptorP
 	return nil
 }
 
-func (x *SchemaExtensionDescriptorProto) GetOperationTypeDefinitions() []*NamedTypeDescriptorProto {
+func (x *SchemaExtensionDescriptorProto) GetQuery() *NamedTypeDescriptorProto {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *SchemaExtensionDescriptorProto) GetMutation() *NamedTypeDescriptorProto {
+	if x != nil {
+		return x.Mutation
+	}
+	return nil
+}
+
+func (x *SchemaExtensionDescriptorProto) GetSubscription() *NamedTypeDescriptorProto {
 	if x != nil {
-		return x.OperationTypeDefinitions
+		return x.Subscription
 	}
 	return nil
 }
@@ -1512,7 +2396,7 @@ type TypeExtensionDescriptorProto struct {
 func (x *TypeExtensionDescriptorProto) Reset() {
 	*x = TypeExtensionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[19]
+		mi := &file_descriptor_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1525,7 +2409,7 @@ func (x *TypeExtensionDescriptorProto) String() string {
 func (*TypeExtensionDescriptorProto) ProtoMessage() {}
 
 func (x *TypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[19]
+	mi := &file_descriptor_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +2422,7 @@ func (x *TypeExtensionDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeExtensionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*TypeExtensionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{19}
+	return file_descriptor_proto_rawDescGZIP(), []int{28}
 }
 
 func (m *TypeExtensionDescriptorProto) GetTypeExtension() isTypeExtensionDescriptorProto_TypeExtension {
@@ -1648,7 +2532,7 @@ type EnumValueDefinitionDescription struct {
 func (x *EnumValueDefinitionDescription) Reset() {
 	*x = EnumValueDefinitionDescription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[20]
+		mi := &file_descriptor_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1661,7 +2545,7 @@ func (x *EnumValueDefinitionDescription) String() string {
 func (*EnumValueDefinitionDescription) ProtoMessage() {}
 
 func (x *EnumValueDefinitionDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[20]
+	mi := &file_descriptor_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,7 +2558,7 @@ func (x *EnumValueDefinitionDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumValueDefinitionDescription.ProtoReflect.Descriptor instead.
 func (*EnumValueDefinitionDescription) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{20}
+	return file_descriptor_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *EnumValueDefinitionDescription) GetDescription() string {
@@ -1713,7 +2597,7 @@ type FieldDefinitionDescriptorProto struct {
 func (x *FieldDefinitionDescriptorProto) Reset() {
 	*x = FieldDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[21]
+		mi := &file_descriptor_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1726,7 +2610,7 @@ func (x *FieldDefinitionDescriptorProto) String() string {
 func (*FieldDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *FieldDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[21]
+	mi := &file_descriptor_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +2623,7 @@ func (x *FieldDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*FieldDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{21}
+	return file_descriptor_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FieldDefinitionDescriptorProto) GetDescription() string {
@@ -1787,12 +2671,15 @@ type InputValueDefinitionDescriptorProto struct {
 	Type         *TypeDescriptorProto        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	DefaultValue *ValueDescriptorProto       `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	Directives   []*DirectiveDescriptorProto `protobuf:"bytes,5,rep,name=directives,proto3" json:"directives,omitempty"`
+	// Set for arguments added by graphqlc, such as the pagination arguments
+	// of @connection fields.
+	Synthesized bool `protobuf:"varint,6,opt,name=synthesized,proto3" json:"synthesized,omitempty"`
 }
 
 func (x *InputValueDefinitionDescriptorProto) Reset() {
 	*x = InputValueDefinitionDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[22]
+		mi := &file_descriptor_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1805,7 +2692,7 @@ func (x *InputValueDefinitionDescriptorProto) String() string {
 func (*InputValueDefinitionDescriptorProto) ProtoMessage() {}
 
 func (x *InputValueDefinitionDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[22]
+	mi := &file_descriptor_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +2705,7 @@ func (x *InputValueDefinitionDescriptorProto) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use InputValueDefinitionDescriptorProto.ProtoReflect.Descriptor instead.
 func (*InputValueDefinitionDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{22}
+	return file_descriptor_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *InputValueDefinitionDescriptorProto) GetDescription() string {
@@ -1856,6 +2743,13 @@ func (x *InputValueDefinitionDescriptorProto) GetDirectives() []*DirectiveDescri
 	return nil
 }
 
+func (x *InputValueDefinitionDescriptorProto) GetSynthesized() bool {
+	if x != nil {
+		return x.Synthesized
+	}
+	return false
+}
+
 type TypeDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1871,7 +2765,7 @@ type TypeDescriptorProto struct {
 func (x *TypeDescriptorProto) Reset() {
 	*x = TypeDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[23]
+		mi := &file_descriptor_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1884,7 +2778,7 @@ func (x *TypeDescriptorProto) String() string {
 func (*TypeDescriptorProto) ProtoMessage() {}
 
 func (x *TypeDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[23]
+	mi := &file_descriptor_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,7 +2791,7 @@ func (x *TypeDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TypeDescriptorProto.ProtoReflect.Descriptor instead.
 func (*TypeDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{23}
+	return file_descriptor_proto_rawDescGZIP(), []int{32}
 }
 
 func (m *TypeDescriptorProto) GetType() isTypeDescriptorProto_Type {
@@ -1950,18 +2844,26 @@ func (*TypeDescriptorProto_ListType) isTypeDescriptorProto_Type() {}
 
 func (*TypeDescriptorProto_NonNullType) isTypeDescriptorProto_Type() {}
 
+// A reference to a type by name. Types are never embedded in the
+// descriptors referring to them, graphqlc.Resolver finds the definition of a
+// reference.
 type NamedTypeDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of the type named and the name of the file defining it, e.g.
+	// graphqlc/builtin.graphql for String. Set by the compiler once every
+	// file is loaded.
+	Kind NamedTypeDescriptorProto_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=graphqlc.NamedTypeDescriptorProto_Kind" json:"kind,omitempty"`
+	File string                        `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
 }
 
 func (x *NamedTypeDescriptorProto) Reset() {
 	*x = NamedTypeDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[24]
+		mi := &file_descriptor_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1974,7 +2876,7 @@ func (x *NamedTypeDescriptorProto) String() string {
 func (*NamedTypeDescriptorProto) ProtoMessage() {}
 
 func (x *NamedTypeDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[24]
+	mi := &file_descriptor_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,7 +2889,7 @@ func (x *NamedTypeDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamedTypeDescriptorProto.ProtoReflect.Descriptor instead.
 func (*NamedTypeDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{24}
+	return file_descriptor_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *NamedTypeDescriptorProto) GetName() string {
@@ -1997,6 +2899,20 @@ func (x *NamedTypeDescriptorProto) GetName() string {
 	return ""
 }
 
+func (x *NamedTypeDescriptorProto) GetKind() NamedTypeDescriptorProto_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return NamedTypeDescriptorProto_UNRESOLVED
+}
+
+func (x *NamedTypeDescriptorProto) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
 type ListTypeDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2008,7 +2924,7 @@ type ListTypeDescriptorProto struct {
 func (x *ListTypeDescriptorProto) Reset() {
 	*x = ListTypeDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[25]
+		mi := &file_descriptor_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2021,7 +2937,7 @@ func (x *ListTypeDescriptorProto) String() string {
 func (*ListTypeDescriptorProto) ProtoMessage() {}
 
 func (x *ListTypeDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[25]
+	mi := &file_descriptor_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2034,7 +2950,7 @@ func (x *ListTypeDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTypeDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ListTypeDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{25}
+	return file_descriptor_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListTypeDescriptorProto) GetType() *TypeDescriptorProto {
@@ -2058,7 +2974,7 @@ type NonNullTypeDescriptorProto struct {
 func (x *NonNullTypeDescriptorProto) Reset() {
 	*x = NonNullTypeDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[26]
+		mi := &file_descriptor_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2071,7 +2987,7 @@ func (x *NonNullTypeDescriptorProto) String() string {
 func (*NonNullTypeDescriptorProto) ProtoMessage() {}
 
 func (x *NonNullTypeDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[26]
+	mi := &file_descriptor_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +3000,7 @@ func (x *NonNullTypeDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NonNullTypeDescriptorProto.ProtoReflect.Descriptor instead.
 func (*NonNullTypeDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{26}
+	return file_descriptor_proto_rawDescGZIP(), []int{35}
 }
 
 func (m *NonNullTypeDescriptorProto) GetType() isNonNullTypeDescriptorProto_Type {
@@ -2129,14 +3045,19 @@ type DirectiveDescriptorProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The arguments given followed by the arguments which are not given and
+	// have a default value, in the order of the definition.
 	Arguments []*ArgumentDescriptorProto `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	// The name of the file defining the directive used, empty if the
+	// directive is not defined.
+	File string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
 }
 
 func (x *DirectiveDescriptorProto) Reset() {
 	*x = DirectiveDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[27]
+		mi := &file_descriptor_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2149,7 +3070,7 @@ func (x *DirectiveDescriptorProto) String() string {
 func (*DirectiveDescriptorProto) ProtoMessage() {}
 
 func (x *DirectiveDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[27]
+	mi := &file_descriptor_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,7 +3083,7 @@ func (x *DirectiveDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectiveDescriptorProto.ProtoReflect.Descriptor instead.
 func (*DirectiveDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{27}
+	return file_descriptor_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DirectiveDescriptorProto) GetName() string {
@@ -2179,6 +3100,13 @@ func (x *DirectiveDescriptorProto) GetArguments() []*ArgumentDescriptorProto {
 	return nil
 }
 
+func (x *DirectiveDescriptorProto) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
 type ArgumentDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2186,12 +3114,15 @@ type ArgumentDescriptorProto struct {
 
 	Name  string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value *ValueDescriptorProto `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Set if the argument is not given, its value is the default value of
+	// its definition.
+	Defaulted bool `protobuf:"varint,3,opt,name=defaulted,proto3" json:"defaulted,omitempty"`
 }
 
 func (x *ArgumentDescriptorProto) Reset() {
 	*x = ArgumentDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[28]
+		mi := &file_descriptor_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2204,7 +3135,7 @@ func (x *ArgumentDescriptorProto) String() string {
 func (*ArgumentDescriptorProto) ProtoMessage() {}
 
 func (x *ArgumentDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[28]
+	mi := &file_descriptor_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2217,7 +3148,7 @@ func (x *ArgumentDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArgumentDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ArgumentDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{28}
+	return file_descriptor_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ArgumentDescriptorProto) GetName() string {
@@ -2234,11 +3165,26 @@ func (x *ArgumentDescriptorProto) GetValue() *ValueDescriptorProto {
 	return nil
 }
 
+func (x *ArgumentDescriptorProto) GetDefaulted() bool {
+	if x != nil {
+		return x.Defaulted
+	}
+	return false
+}
+
 type ValueDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The value exactly as written in the source, e.g. "1.50", "\"\\u00e9\""
+	// or "[1, 2]". Plugins may use it to reproduce a value without loss.
+	Raw string `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	// Numbers use the narrowest field which holds them exactly: int_value,
+	// then int64_value, for Int values and float_value, then double_value,
+	// for Float values. A number beyond the range of int64 or float64 is a
+	// big_value.
+	//
 	// Types that are assignable to Value:
 	//	*ValueDescriptorProto_VariableValue
 	//	*ValueDescriptorProto_IntValue
@@ -2249,13 +3195,16 @@ type ValueDescriptorProto struct {
 	//	*ValueDescriptorProto_EnumValue
 	//	*ValueDescriptorProto_ListValue
 	//	*ValueDescriptorProto_ObjectValue
+	//	*ValueDescriptorProto_Int64Value
+	//	*ValueDescriptorProto_DoubleValue
+	//	*ValueDescriptorProto_BigValue
 	Value isValueDescriptorProto_Value `protobuf_oneof:"value"`
 }
 
 func (x *ValueDescriptorProto) Reset() {
 	*x = ValueDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[29]
+		mi := &file_descriptor_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2268,7 +3217,7 @@ func (x *ValueDescriptorProto) String() string {
 func (*ValueDescriptorProto) ProtoMessage() {}
 
 func (x *ValueDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[29]
+	mi := &file_descriptor_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2281,7 +3230,14 @@ func (x *ValueDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValueDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ValueDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{29}
+	return file_descriptor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ValueDescriptorProto) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
 }
 
 func (m *ValueDescriptorProto) GetValue() isValueDescriptorProto_Value {
@@ -2354,6 +3310,27 @@ func (x *ValueDescriptorProto) GetObjectValue() *ObjectValueDescriptorProto {
 	return nil
 }
 
+func (x *ValueDescriptorProto) GetInt64Value() int64 {
+	if x, ok := x.GetValue().(*ValueDescriptorProto_Int64Value); ok {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (x *ValueDescriptorProto) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*ValueDescriptorProto_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *ValueDescriptorProto) GetBigValue() string {
+	if x, ok := x.GetValue().(*ValueDescriptorProto_BigValue); ok {
+		return x.BigValue
+	}
+	return ""
+}
+
 type isValueDescriptorProto_Value interface {
 	isValueDescriptorProto_Value()
 }
@@ -2394,6 +3371,18 @@ type ValueDescriptorProto_ObjectValue struct {
 	ObjectValue *ObjectValueDescriptorProto `protobuf:"bytes,10,opt,name=object_value,json=objectValue,proto3,oneof"`
 }
 
+type ValueDescriptorProto_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,11,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type ValueDescriptorProto_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,12,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type ValueDescriptorProto_BigValue struct {
+	BigValue string `protobuf:"bytes,13,opt,name=big_value,json=bigValue,proto3,oneof"` // as written, e.g. "123456789012345678901234567890"
+}
+
 func (*ValueDescriptorProto_VariableValue) isValueDescriptorProto_Value() {}
 
 func (*ValueDescriptorProto_IntValue) isValueDescriptorProto_Value() {}
@@ -2412,6 +3401,12 @@ func (*ValueDescriptorProto_ListValue) isValueDescriptorProto_Value() {}
 
 func (*ValueDescriptorProto_ObjectValue) isValueDescriptorProto_Value() {}
 
+func (*ValueDescriptorProto_Int64Value) isValueDescriptorProto_Value() {}
+
+func (*ValueDescriptorProto_DoubleValue) isValueDescriptorProto_Value() {}
+
+func (*ValueDescriptorProto_BigValue) isValueDescriptorProto_Value() {}
+
 type VariableDescriptorProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2423,7 +3418,7 @@ type VariableDescriptorProto struct {
 func (x *VariableDescriptorProto) Reset() {
 	*x = VariableDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[30]
+		mi := &file_descriptor_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2436,7 +3431,7 @@ func (x *VariableDescriptorProto) String() string {
 func (*VariableDescriptorProto) ProtoMessage() {}
 
 func (x *VariableDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[30]
+	mi := &file_descriptor_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2449,7 +3444,7 @@ func (x *VariableDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VariableDescriptorProto.ProtoReflect.Descriptor instead.
 func (*VariableDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{30}
+	return file_descriptor_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *VariableDescriptorProto) GetName() string {
@@ -2470,7 +3465,7 @@ type NullValueDescriptorProto struct {
 func (x *NullValueDescriptorProto) Reset() {
 	*x = NullValueDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[31]
+		mi := &file_descriptor_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2483,7 +3478,7 @@ func (x *NullValueDescriptorProto) String() string {
 func (*NullValueDescriptorProto) ProtoMessage() {}
 
 func (x *NullValueDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[31]
+	mi := &file_descriptor_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +3491,7 @@ func (x *NullValueDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NullValueDescriptorProto.ProtoReflect.Descriptor instead.
 func (*NullValueDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{31}
+	return file_descriptor_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *NullValueDescriptorProto) GetValue() string {
@@ -2517,7 +3512,7 @@ type EnumValueDescriptorProto struct {
 func (x *EnumValueDescriptorProto) Reset() {
 	*x = EnumValueDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[32]
+		mi := &file_descriptor_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2530,7 +3525,7 @@ func (x *EnumValueDescriptorProto) String() string {
 func (*EnumValueDescriptorProto) ProtoMessage() {}
 
 func (x *EnumValueDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[32]
+	mi := &file_descriptor_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +3538,7 @@ func (x *EnumValueDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumValueDescriptorProto.ProtoReflect.Descriptor instead.
 func (*EnumValueDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{32}
+	return file_descriptor_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *EnumValueDescriptorProto) GetValue() string {
@@ -2564,7 +3559,7 @@ type ListValueDescriptorProto struct {
 func (x *ListValueDescriptorProto) Reset() {
 	*x = ListValueDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[33]
+		mi := &file_descriptor_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2577,7 +3572,7 @@ func (x *ListValueDescriptorProto) String() string {
 func (*ListValueDescriptorProto) ProtoMessage() {}
 
 func (x *ListValueDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[33]
+	mi := &file_descriptor_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +3585,7 @@ func (x *ListValueDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListValueDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ListValueDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{33}
+	return file_descriptor_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListValueDescriptorProto) GetValues() []*ValueDescriptorProto {
@@ -2611,7 +3606,7 @@ type ObjectValueDescriptorProto struct {
 func (x *ObjectValueDescriptorProto) Reset() {
 	*x = ObjectValueDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[34]
+		mi := &file_descriptor_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +3619,7 @@ func (x *ObjectValueDescriptorProto) String() string {
 func (*ObjectValueDescriptorProto) ProtoMessage() {}
 
 func (x *ObjectValueDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[34]
+	mi := &file_descriptor_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +3632,7 @@ func (x *ObjectValueDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectValueDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ObjectValueDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{34}
+	return file_descriptor_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ObjectValueDescriptorProto) GetFields() []*ObjectFieldDescriptorProto {
@@ -2659,7 +3654,7 @@ type ObjectFieldDescriptorProto struct {
 func (x *ObjectFieldDescriptorProto) Reset() {
 	*x = ObjectFieldDescriptorProto{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_descriptor_proto_msgTypes[35]
+		mi := &file_descriptor_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2672,7 +3667,7 @@ func (x *ObjectFieldDescriptorProto) String() string {
 func (*ObjectFieldDescriptorProto) ProtoMessage() {}
 
 func (x *ObjectFieldDescriptorProto) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[35]
+	mi := &file_descriptor_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2685,7 +3680,7 @@ func (x *ObjectFieldDescriptorProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectFieldDescriptorProto.ProtoReflect.Descriptor instead.
 func (*ObjectFieldDescriptorProto) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{35}
+	return file_descriptor_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ObjectFieldDescriptorProto) GetName() string {
@@ -2702,6 +3697,99 @@ func (x *ObjectFieldDescriptorProto) GetValue() *ValueDescriptorProto {
 	return nil
 }
 
+type SourceCodeInfo_Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies which part of the FileDescriptorGraphql was defined at
+	// this location. Each element is a field number or an index into a
+	// repeated field, e.g. the path of the second field of the first
+	// object is [4 (objects), 0, 5 (fields), 1].
+	Path []int32 `protobuf:"varint,1,rep,packed,name=path,proto3" json:"path,omitempty"`
+	// Always has exactly three or four elements: start line, start
+	// column, end line (optional, otherwise assumed same as start line),
+	// end column. Lines and columns are zero-based, columns count bytes.
+	// The end column is exclusive.
+	Span []int32 `protobuf:"varint,2,rep,packed,name=span,proto3" json:"span,omitempty"`
+	// Comments directly attached to the definition, with the leading #
+	// removed. A comment on the lines directly before a definition is
+	// its leading comment. A comment on the same line after a definition,
+	// or on the lines after it and followed by a blank line, is its
+	// trailing comment. Other comments between the previous token and
+	// the definition, separated by blank lines, are detached comments.
+	LeadingComments         string   `protobuf:"bytes,3,opt,name=leading_comments,json=leadingComments,proto3" json:"leading_comments,omitempty"`
+	TrailingComments        string   `protobuf:"bytes,4,opt,name=trailing_comments,json=trailingComments,proto3" json:"trailing_comments,omitempty"`
+	LeadingDetachedComments []string `protobuf:"bytes,6,rep,name=leading_detached_comments,json=leadingDetachedComments,proto3" json:"leading_detached_comments,omitempty"`
+}
+
+func (x *SourceCodeInfo_Location) Reset() {
+	*x = SourceCodeInfo_Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_descriptor_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceCodeInfo_Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceCodeInfo_Location) ProtoMessage() {}
+
+func (x *SourceCodeInfo_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceCodeInfo_Location.ProtoReflect.Descriptor instead.
+func (*SourceCodeInfo_Location) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SourceCodeInfo_Location) GetPath() []int32 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *SourceCodeInfo_Location) GetSpan() []int32 {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+func (x *SourceCodeInfo_Location) GetLeadingComments() string {
+	if x != nil {
+		return x.LeadingComments
+	}
+	return ""
+}
+
+func (x *SourceCodeInfo_Location) GetTrailingComments() string {
+	if x != nil {
+		return x.TrailingComments
+	}
+	return ""
+}
+
+func (x *SourceCodeInfo_Location) GetLeadingDetachedComments() []string {
+	if x != nil {
+		return x.LeadingDetachedComments
+	}
+	return nil
+}
+
 var File_descriptor_proto protoreflect.FileDescriptor
 
 var file_descriptor_proto_rawDesc = []byte{
@@ -2711,10 +3799,12 @@ var file_descriptor_proto_rawDesc = []byte{
 	0x74, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xcf, 0x05, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x65, 0x44,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xb8, 0x07, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x65, 0x44,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
 	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x55, 0x0a,
@@ -2756,470 +3846,685 @@ var file_descriptor_proto_rawDesc = []byte{
 	0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70,
 	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x15, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x08, 0x6d,
-	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x75,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x22, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe3, 0x01,
-	0x0a, 0x20, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x58, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x25, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x14,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x12, 0x74, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x23, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x09,
+	0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a,
+	0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0xa0, 0x02, 0x0a, 0x0e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x63, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0xce, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x6c, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x6c, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x6c, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xa2, 0x03, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x4a, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x65, 0x12, 0x5e, 0x0a, 0x14, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x13, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
 	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
 	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x22, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x22, 0x36, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09,
+	0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x55, 0x54,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x42, 0x53, 0x43,
+	0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x22, 0xf3, 0x01, 0x0a, 0x21, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22,
+	0x88, 0x02, 0x0a, 0x17, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63,
 	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x23, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x50, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x22, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x49, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0d, 0x74, 0x79, 0x70,
+	0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4a,
+	0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x1b, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x63, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x18, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x3f, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x52, 0x0a, 0x0f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x52, 0x0a, 0x0f, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x02, 0x0a, 0x1d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x63, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
 	0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
 	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xe4, 0x01, 0x0a,
-	0x26, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a,
-	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x77, 0x0a, 0x1d, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x1d, 0x49,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x49, 0x0a, 0x0e,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
 	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
 	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x05, 0x22,
+	0xcd, 0x02, 0x0a, 0x22, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x22,
+	0xe3, 0x01, 0x0a, 0x20, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x58, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59,
+	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x74, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x23, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x7c, 0x0a, 0x22, 0x53,
+	0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x23, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
 	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x22, 0x55, 0x6e, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42, 0x0a,
+	0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x88, 0x02, 0x0a, 0x22, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
 	0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73,
 	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
 	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54,
 	0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22,
-	0xcc, 0x01, 0x0a, 0x2b, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xdf,
-	0x01, 0x0a, 0x21, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40,
-	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x22, 0xc6, 0x01, 0x0a, 0x2a, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x74, 0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x22, 0xae, 0x02, 0x0a, 0x26, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
 	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x28, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73,
-	0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x31, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xd9, 0x01, 0x0a,
-	0x22, 0x54, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x55, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79,
-	0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x1e, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12,
-	0x60, 0x0a, 0x1a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e,
-	0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x18, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xb2, 0x05, 0x0a, 0x1c, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x62, 0x0a, 0x15, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x63, 0x61,
-	0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48,
-	0x00, 0x52, 0x13, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x15, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x18, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52,
-	0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x14, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x12,
-	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
-	0x65, 0x78, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x45,
-	0x78, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x18, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x1e, 0x45, 0x6e, 0x75, 0x6d, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x1e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x8b, 0x02, 0x0a, 0x25, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79,
+	0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08,
+	0x04, 0x10, 0x05, 0x22, 0xe5, 0x01, 0x0a, 0x22, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x2b,
+	0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x21, 0x45,
+	0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44,
 	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b,
-	0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xc6, 0x01, 0x0a,
+	0x2a, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x28, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x31, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70,
 	0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
 	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42,
-	0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x23, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x22, 0x54, 0x79, 0x70,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x55, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x02, 0x0a, 0x1e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x06, 0x22, 0xb2, 0x05, 0x0a, 0x1c, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x62, 0x0a, 0x15, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53,
+	0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x48, 0x00, 0x52, 0x13, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x54, 0x79, 0x70, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x15, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x18,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48,
+	0x00, 0x52, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x14, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00,
+	0x52, 0x12, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x65, 0x78, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x45, 0x78, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x7c, 0x0a, 0x1b, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x18, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x1e, 0x45, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
+	0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x1e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
 	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61,
-	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x12, 0x4b, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x23, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x64,
+	0x22, 0xf0, 0x01, 0x0a, 0x13, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70,
 	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x52, 0x0a, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a,
-	0x13, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48,
-	0x00, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x6e,
-	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x6f,
+	0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x4a, 0x0a, 0x0d, 0x6e, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x63, 0x2e, 0x4e, 0x6f, 0x6e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0b,
+	0x6e, 0x6f, 0x6e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x18, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x64, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
+	0x0a, 0x55, 0x4e, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42, 0x4a,
+	0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
+	0x43, 0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12,
+	0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x06, 0x22, 0x4c, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x1a, 0x4e, 0x6f,
 	0x6e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x6f, 0x6e, 0x4e,
-	0x75, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x2e, 0x0a, 0x18, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x4c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xab, 0x01,
-	0x0a, 0x1a, 0x4e, 0x6f, 0x6e, 0x4e, 0x75, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x0a,
-	0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-	0x64, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6f, 0x0a, 0x18, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x61,
-	0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x17,
-	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x48, 0x00, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x22, 0x8c, 0x05, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
+	0x61, 0x77, 0x12, 0x4a, 0x0a, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52,
+	0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x25, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
+	0x61, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a,
+	0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x75, 0x6c, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x62, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x45, 0x6e, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x36, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5a, 0x0a,
+	0x1a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x3c, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x1a, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61,
 	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x93, 0x04, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x4a, 0x0a, 0x0e, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6c,
-	0x65, 0x61, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x63, 0x2e, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09,
-	0x6e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x6e, 0x75,
-	0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43,
-	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48,
-	0x00, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a, 0x18, 0x45, 0x6e, 0x75, 0x6d,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x36, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5a,
-	0x0a, 0x1a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x3c, 0x0a, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x1a, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x2a, 0x96, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53,
-	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x52, 0x41, 0x47,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x50,
-	0x52, 0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45,
-	0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x2a, 0xd2, 0x01, 0x0a, 0x1b,
-	0x54, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x41, 0x4c, 0x41,
-	0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12,
-	0x14, 0x0a, 0x10, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x05, 0x12, 0x09, 0x0a,
-	0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d,
-	0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
-	0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x4f, 0x42, 0x4a, 0x45,
-	0x43, 0x54, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x46, 0x49,
-	0x45, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0a,
-	0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
-	0x61, 0x6d, 0x6c, 0x69, 0x74, 0x6f, 0x77, 0x69, 0x74, 0x7a, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x71, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
-	0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x2a, 0xaf, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4d, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55,
+	0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x52, 0x41, 0x47, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04,
+	0x12, 0x13, 0x0a, 0x0f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x50, 0x52,
+	0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41,
+	0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x07, 0x2a, 0xd2, 0x01, 0x0a, 0x1b, 0x54, 0x79, 0x70, 0x65, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a,
+	0x13, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46,
+	0x41, 0x43, 0x45, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x06,
+	0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e,
+	0x55, 0x4d, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e,
+	0x50, 0x55, 0x54, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0a, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x69, 0x74, 0x6f, 0x77, 0x69,
+	0x74, 0x7a, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
+	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3234,129 +4539,173 @@ func file_descriptor_proto_rawDescGZIP() []byte {
 	return file_descriptor_proto_rawDescData
 }
 
-var file_descriptor_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_descriptor_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_descriptor_proto_goTypes = []interface{}{
 	(ExecutableDirectiveLocation)(0),                          // 0: graphqlc.ExecutableDirectiveLocation
 	(TypeSystemDirectiveLocation)(0),                          // 1: graphqlc.TypeSystemDirectiveLocation
-	(*FileDescriptorSet)(nil),                                 // 2: graphqlc.FileDescriptorSet
-	(*FileDescriptorGraphql)(nil),                             // 3: graphqlc.FileDescriptorGraphql
-	(*SchemaDescriptorProto)(nil),                             // 4: graphqlc.SchemaDescriptorProto
-	(*DirectiveDefinitionDescriptorProto)(nil),                // 5: graphqlc.DirectiveDefinitionDescriptorProto
-	(*DirectiveLocationDescriptorProto)(nil),                  // 6: graphqlc.DirectiveLocationDescriptorProto
-	(*ScalarTypeDefinitionDescriptorProto)(nil),               // 7: graphqlc.ScalarTypeDefinitionDescriptorProto
-	(*ScalarTypeExtensionDescriptorProto)(nil),                // 8: graphqlc.ScalarTypeExtensionDescriptorProto
-	(*ObjectTypeDefinitionDescriptorProto)(nil),               // 9: graphqlc.ObjectTypeDefinitionDescriptorProto
-	(*ObjectTypeExtensionDescriptorProto)(nil),                // 10: graphqlc.ObjectTypeExtensionDescriptorProto
-	(*InterfaceTypeDefinitionDescriptorProto)(nil),            // 11: graphqlc.InterfaceTypeDefinitionDescriptorProto
-	(*InterfaceTypeExtensionDescriptorProto)(nil),             // 12: graphqlc.InterfaceTypeExtensionDescriptorProto
-	(*UnionTypeDefinitionDescriptorProto)(nil),                // 13: graphqlc.UnionTypeDefinitionDescriptorProto
-	(*UnionTypeExtensionDefinitionDescriptorProto)(nil),       // 14: graphqlc.UnionTypeExtensionDefinitionDescriptorProto
-	(*EnumTypeDefinitionDescriptorProto)(nil),                 // 15: graphqlc.EnumTypeDefinitionDescriptorProto
-	(*EnumTypeExtensionDefinitionDescriptorProto)(nil),        // 16: graphqlc.EnumTypeExtensionDefinitionDescriptorProto
-	(*InputObjectTypeDefinitionDescriptorProto)(nil),          // 17: graphqlc.InputObjectTypeDefinitionDescriptorProto
-	(*InputObjectTypeExtensionDefinitionDescriptorProto)(nil), // 18: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto
-	(*TypeSystemExtensionDescriptorProto)(nil),                // 19: graphqlc.TypeSystemExtensionDescriptorProto
-	(*SchemaExtensionDescriptorProto)(nil),                    // 20: graphqlc.SchemaExtensionDescriptorProto
-	(*TypeExtensionDescriptorProto)(nil),                      // 21: graphqlc.TypeExtensionDescriptorProto
-	(*EnumValueDefinitionDescription)(nil),                    // 22: graphqlc.EnumValueDefinitionDescription
-	(*FieldDefinitionDescriptorProto)(nil),                    // 23: graphqlc.FieldDefinitionDescriptorProto
-	(*InputValueDefinitionDescriptorProto)(nil),               // 24: graphqlc.InputValueDefinitionDescriptorProto
-	(*TypeDescriptorProto)(nil),                               // 25: graphqlc.TypeDescriptorProto
-	(*NamedTypeDescriptorProto)(nil),                          // 26: graphqlc.NamedTypeDescriptorProto
-	(*ListTypeDescriptorProto)(nil),                           // 27: graphqlc.ListTypeDescriptorProto
-	(*NonNullTypeDescriptorProto)(nil),                        // 28: graphqlc.NonNullTypeDescriptorProto
-	(*DirectiveDescriptorProto)(nil),                          // 29: graphqlc.DirectiveDescriptorProto
-	(*ArgumentDescriptorProto)(nil),                           // 30: graphqlc.ArgumentDescriptorProto
-	(*ValueDescriptorProto)(nil),                              // 31: graphqlc.ValueDescriptorProto
-	(*VariableDescriptorProto)(nil),                           // 32: graphqlc.VariableDescriptorProto
-	(*NullValueDescriptorProto)(nil),                          // 33: graphqlc.NullValueDescriptorProto
-	(*EnumValueDescriptorProto)(nil),                          // 34: graphqlc.EnumValueDescriptorProto
-	(*ListValueDescriptorProto)(nil),                          // 35: graphqlc.ListValueDescriptorProto
-	(*ObjectValueDescriptorProto)(nil),                        // 36: graphqlc.ObjectValueDescriptorProto
-	(*ObjectFieldDescriptorProto)(nil),                        // 37: graphqlc.ObjectFieldDescriptorProto
+	(OperationDescriptorProto_Operation)(0),                   // 2: graphqlc.OperationDescriptorProto.Operation
+	(NamedTypeDescriptorProto_Kind)(0),                        // 3: graphqlc.NamedTypeDescriptorProto.Kind
+	(*FileDescriptorSet)(nil),                                 // 4: graphqlc.FileDescriptorSet
+	(*FileDescriptorGraphql)(nil),                             // 5: graphqlc.FileDescriptorGraphql
+	(*SourceCodeInfo)(nil),                                    // 6: graphqlc.SourceCodeInfo
+	(*OperationDescriptorProto)(nil),                          // 7: graphqlc.OperationDescriptorProto
+	(*VariableDefinitionDescriptorProto)(nil),                 // 8: graphqlc.VariableDefinitionDescriptorProto
+	(*FragmentDescriptorProto)(nil),                           // 9: graphqlc.FragmentDescriptorProto
+	(*SelectionSetDescriptorProto)(nil),                       // 10: graphqlc.SelectionSetDescriptorProto
+	(*SelectionDescriptorProto)(nil),                          // 11: graphqlc.SelectionDescriptorProto
+	(*FieldSelectionDescriptorProto)(nil),                     // 12: graphqlc.FieldSelectionDescriptorProto
+	(*FragmentSpreadDescriptorProto)(nil),                     // 13: graphqlc.FragmentSpreadDescriptorProto
+	(*InlineFragmentDescriptorProto)(nil),                     // 14: graphqlc.InlineFragmentDescriptorProto
+	(*SchemaDescriptorProto)(nil),                             // 15: graphqlc.SchemaDescriptorProto
+	(*DirectiveDefinitionDescriptorProto)(nil),                // 16: graphqlc.DirectiveDefinitionDescriptorProto
+	(*DirectiveLocationDescriptorProto)(nil),                  // 17: graphqlc.DirectiveLocationDescriptorProto
+	(*ScalarTypeDefinitionDescriptorProto)(nil),               // 18: graphqlc.ScalarTypeDefinitionDescriptorProto
+	(*ScalarTypeExtensionDescriptorProto)(nil),                // 19: graphqlc.ScalarTypeExtensionDescriptorProto
+	(*ObjectTypeDefinitionDescriptorProto)(nil),               // 20: graphqlc.ObjectTypeDefinitionDescriptorProto
+	(*ObjectTypeExtensionDescriptorProto)(nil),                // 21: graphqlc.ObjectTypeExtensionDescriptorProto
+	(*InterfaceTypeDefinitionDescriptorProto)(nil),            // 22: graphqlc.InterfaceTypeDefinitionDescriptorProto
+	(*InterfaceTypeExtensionDescriptorProto)(nil),             // 23: graphqlc.InterfaceTypeExtensionDescriptorProto
+	(*UnionTypeDefinitionDescriptorProto)(nil),                // 24: graphqlc.UnionTypeDefinitionDescriptorProto
+	(*UnionTypeExtensionDefinitionDescriptorProto)(nil),       // 25: graphqlc.UnionTypeExtensionDefinitionDescriptorProto
+	(*EnumTypeDefinitionDescriptorProto)(nil),                 // 26: graphqlc.EnumTypeDefinitionDescriptorProto
+	(*EnumTypeExtensionDefinitionDescriptorProto)(nil),        // 27: graphqlc.EnumTypeExtensionDefinitionDescriptorProto
+	(*InputObjectTypeDefinitionDescriptorProto)(nil),          // 28: graphqlc.InputObjectTypeDefinitionDescriptorProto
+	(*InputObjectTypeExtensionDefinitionDescriptorProto)(nil), // 29: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto
+	(*TypeSystemExtensionDescriptorProto)(nil),                // 30: graphqlc.TypeSystemExtensionDescriptorProto
+	(*SchemaExtensionDescriptorProto)(nil),                    // 31: graphqlc.SchemaExtensionDescriptorProto
+	(*TypeExtensionDescriptorProto)(nil),                      // 32: graphqlc.TypeExtensionDescriptorProto
+	(*EnumValueDefinitionDescription)(nil),                    // 33: graphqlc.EnumValueDefinitionDescription
+	(*FieldDefinitionDescriptorProto)(nil),                    // 34: graphqlc.FieldDefinitionDescriptorProto
+	(*InputValueDefinitionDescriptorProto)(nil),               // 35: graphqlc.InputValueDefinitionDescriptorProto
+	(*TypeDescriptorProto)(nil),                               // 36: graphqlc.TypeDescriptorProto
+	(*NamedTypeDescriptorProto)(nil),                          // 37: graphqlc.NamedTypeDescriptorProto
+	(*ListTypeDescriptorProto)(nil),                           // 38: graphqlc.ListTypeDescriptorProto
+	(*NonNullTypeDescriptorProto)(nil),                        // 39: graphqlc.NonNullTypeDescriptorProto
+	(*DirectiveDescriptorProto)(nil),                          // 40: graphqlc.DirectiveDescriptorProto
+	(*ArgumentDescriptorProto)(nil),                           // 41: graphqlc.ArgumentDescriptorProto
+	(*ValueDescriptorProto)(nil),                              // 42: graphqlc.ValueDescriptorProto
+	(*VariableDescriptorProto)(nil),                           // 43: graphqlc.VariableDescriptorProto
+	(*NullValueDescriptorProto)(nil),                          // 44: graphqlc.NullValueDescriptorProto
+	(*EnumValueDescriptorProto)(nil),                          // 45: graphqlc.EnumValueDescriptorProto
+	(*ListValueDescriptorProto)(nil),                          // 46: graphqlc.ListValueDescriptorProto
+	(*ObjectValueDescriptorProto)(nil),                        // 47: graphqlc.ObjectValueDescriptorProto
+	(*ObjectFieldDescriptorProto)(nil),                        // 48: graphqlc.ObjectFieldDescriptorProto
+	(*SourceCodeInfo_Location)(nil),                           // 49: graphqlc.SourceCodeInfo.Location
 }
 var file_descriptor_proto_depIdxs = []int32{
-	3,  // 0: graphqlc.FileDescriptorSet.file:type_name -> graphqlc.FileDescriptorGraphql
-	4,  // 1: graphqlc.FileDescriptorGraphql.schema:type_name -> graphqlc.SchemaDescriptorProto
-	19, // 2: graphqlc.FileDescriptorGraphql.type_extensions:type_name -> graphqlc.TypeSystemExtensionDescriptorProto
-	5,  // 3: graphqlc.FileDescriptorGraphql.directives:type_name -> graphqlc.DirectiveDefinitionDescriptorProto
-	7,  // 4: graphqlc.FileDescriptorGraphql.scalars:type_name -> graphqlc.ScalarTypeDefinitionDescriptorProto
-	9,  // 5: graphqlc.FileDescriptorGraphql.objects:type_name -> graphqlc.ObjectTypeDefinitionDescriptorProto
-	11, // 6: graphqlc.FileDescriptorGraphql.interfaces:type_name -> graphqlc.InterfaceTypeDefinitionDescriptorProto
-	13, // 7: graphqlc.FileDescriptorGraphql.unions:type_name -> graphqlc.UnionTypeDefinitionDescriptorProto
-	15, // 8: graphqlc.FileDescriptorGraphql.enums:type_name -> graphqlc.EnumTypeDefinitionDescriptorProto
-	17, // 9: graphqlc.FileDescriptorGraphql.input_objects:type_name -> graphqlc.InputObjectTypeDefinitionDescriptorProto
-	29, // 10: graphqlc.SchemaDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	9,  // 11: graphqlc.SchemaDescriptorProto.query:type_name -> graphqlc.ObjectTypeDefinitionDescriptorProto
-	9,  // 12: graphqlc.SchemaDescriptorProto.mutation:type_name -> graphqlc.ObjectTypeDefinitionDescriptorProto
-	9,  // 13: graphqlc.SchemaDescriptorProto.subscription:type_name -> graphqlc.ObjectTypeDefinitionDescriptorProto
-	24, // 14: graphqlc.DirectiveDefinitionDescriptorProto.arguments:type_name -> graphqlc.InputValueDefinitionDescriptorProto
-	6,  // 15: graphqlc.DirectiveDefinitionDescriptorProto.locations:type_name -> graphqlc.DirectiveLocationDescriptorProto
-	0,  // 16: graphqlc.DirectiveLocationDescriptorProto.executable_location:type_name -> graphqlc.ExecutableDirectiveLocation
-	1,  // 17: graphqlc.DirectiveLocationDescriptorProto.type_system_location:type_name -> graphqlc.TypeSystemDirectiveLocation
-	29, // 18: graphqlc.ScalarTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	29, // 19: graphqlc.ScalarTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	11, // 20: graphqlc.ObjectTypeDefinitionDescriptorProto.implements:type_name -> graphqlc.InterfaceTypeDefinitionDescriptorProto
-	29, // 21: graphqlc.ObjectTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	23, // 22: graphqlc.ObjectTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
-	11, // 23: graphqlc.ObjectTypeExtensionDescriptorProto.implements:type_name -> graphqlc.InterfaceTypeDefinitionDescriptorProto
-	29, // 24: graphqlc.ObjectTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	23, // 25: graphqlc.ObjectTypeExtensionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
-	29, // 26: graphqlc.InterfaceTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	23, // 27: graphqlc.InterfaceTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
-	29, // 28: graphqlc.InterfaceTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	23, // 29: graphqlc.InterfaceTypeExtensionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
-	29, // 30: graphqlc.UnionTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	26, // 31: graphqlc.UnionTypeDefinitionDescriptorProto.member_types:type_name -> graphqlc.NamedTypeDescriptorProto
-	29, // 32: graphqlc.UnionTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	26, // 33: graphqlc.UnionTypeExtensionDefinitionDescriptorProto.member_types:type_name -> graphqlc.NamedTypeDescriptorProto
-	29, // 34: graphqlc.EnumTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	22, // 35: graphqlc.EnumTypeDefinitionDescriptorProto.values:type_name -> graphqlc.EnumValueDefinitionDescription
-	29, // 36: graphqlc.EnumTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	22, // 37: graphqlc.EnumTypeExtensionDefinitionDescriptorProto.values:type_name -> graphqlc.EnumValueDefinitionDescription
-	29, // 38: graphqlc.InputObjectTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	24, // 39: graphqlc.InputObjectTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.InputValueDefinitionDescriptorProto
-	29, // 40: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	24, // 41: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto.fields:type_name -> graphqlc.InputValueDefinitionDescriptorProto
-	20, // 42: graphqlc.TypeSystemExtensionDescriptorProto.schema_extension:type_name -> graphqlc.SchemaExtensionDescriptorProto
-	21, // 43: graphqlc.TypeSystemExtensionDescriptorProto.type_extension:type_name -> graphqlc.TypeExtensionDescriptorProto
-	29, // 44: graphqlc.SchemaExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	26, // 45: graphqlc.SchemaExtensionDescriptorProto.operation_type_definitions:type_name -> graphqlc.NamedTypeDescriptorProto
-	8,  // 46: graphqlc.TypeExtensionDescriptorProto.scalar_type_extension:type_name -> graphqlc.ScalarTypeExtensionDescriptorProto
-	10, // 47: graphqlc.TypeExtensionDescriptorProto.object_type_extension:type_name -> graphqlc.ObjectTypeExtensionDescriptorProto
-	12, // 48: graphqlc.TypeExtensionDescriptorProto.interface_type_extension:type_name -> graphqlc.InterfaceTypeExtensionDescriptorProto
-	14, // 49: graphqlc.TypeExtensionDescriptorProto.union_type_extension:type_name -> graphqlc.UnionTypeExtensionDefinitionDescriptorProto
-	16, // 50: graphqlc.TypeExtensionDescriptorProto.enum_type_extions:type_name -> graphqlc.EnumTypeExtensionDefinitionDescriptorProto
-	18, // 51: graphqlc.TypeExtensionDescriptorProto.input_object_type_extension:type_name -> graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto
-	29, // 52: graphqlc.EnumValueDefinitionDescription.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	24, // 53: graphqlc.FieldDefinitionDescriptorProto.arguments:type_name -> graphqlc.InputValueDefinitionDescriptorProto
-	25, // 54: graphqlc.FieldDefinitionDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
-	29, // 55: graphqlc.FieldDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	25, // 56: graphqlc.InputValueDefinitionDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
-	31, // 57: graphqlc.InputValueDefinitionDescriptorProto.default_value:type_name -> graphqlc.ValueDescriptorProto
-	29, // 58: graphqlc.InputValueDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
-	26, // 59: graphqlc.TypeDescriptorProto.named_type:type_name -> graphqlc.NamedTypeDescriptorProto
-	27, // 60: graphqlc.TypeDescriptorProto.list_type:type_name -> graphqlc.ListTypeDescriptorProto
-	28, // 61: graphqlc.TypeDescriptorProto.non_null_type:type_name -> graphqlc.NonNullTypeDescriptorProto
-	25, // 62: graphqlc.ListTypeDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
-	26, // 63: graphqlc.NonNullTypeDescriptorProto.named_type:type_name -> graphqlc.NamedTypeDescriptorProto
-	27, // 64: graphqlc.NonNullTypeDescriptorProto.list_type:type_name -> graphqlc.ListTypeDescriptorProto
-	30, // 65: graphqlc.DirectiveDescriptorProto.arguments:type_name -> graphqlc.ArgumentDescriptorProto
-	31, // 66: graphqlc.ArgumentDescriptorProto.value:type_name -> graphqlc.ValueDescriptorProto
-	32, // 67: graphqlc.ValueDescriptorProto.variable_value:type_name -> graphqlc.VariableDescriptorProto
-	33, // 68: graphqlc.ValueDescriptorProto.null_value:type_name -> graphqlc.NullValueDescriptorProto
-	34, // 69: graphqlc.ValueDescriptorProto.enum_value:type_name -> graphqlc.EnumValueDescriptorProto
-	35, // 70: graphqlc.ValueDescriptorProto.list_value:type_name -> graphqlc.ListValueDescriptorProto
-	36, // 71: graphqlc.ValueDescriptorProto.object_value:type_name -> graphqlc.ObjectValueDescriptorProto
-	31, // 72: graphqlc.ListValueDescriptorProto.values:type_name -> graphqlc.ValueDescriptorProto
-	37, // 73: graphqlc.ObjectValueDescriptorProto.fields:type_name -> graphqlc.ObjectFieldDescriptorProto
-	31, // 74: graphqlc.ObjectFieldDescriptorProto.value:type_name -> graphqlc.ValueDescriptorProto
-	75, // [75:75] is the sub-list for method output_type
-	75, // [75:75] is the sub-list for method input_type
-	75, // [75:75] is the sub-list for extension type_name
-	75, // [75:75] is the sub-list for extension extendee
-	0,  // [0:75] is the sub-list for field type_name
+	5,   // 0: graphqlc.FileDescriptorSet.file:type_name -> graphqlc.FileDescriptorGraphql
+	15,  // 1: graphqlc.FileDescriptorGraphql.schema:type_name -> graphqlc.SchemaDescriptorProto
+	30,  // 2: graphqlc.FileDescriptorGraphql.type_extensions:type_name -> graphqlc.TypeSystemExtensionDescriptorProto
+	16,  // 3: graphqlc.FileDescriptorGraphql.directives:type_name -> graphqlc.DirectiveDefinitionDescriptorProto
+	18,  // 4: graphqlc.FileDescriptorGraphql.scalars:type_name -> graphqlc.ScalarTypeDefinitionDescriptorProto
+	20,  // 5: graphqlc.FileDescriptorGraphql.objects:type_name -> graphqlc.ObjectTypeDefinitionDescriptorProto
+	22,  // 6: graphqlc.FileDescriptorGraphql.interfaces:type_name -> graphqlc.InterfaceTypeDefinitionDescriptorProto
+	24,  // 7: graphqlc.FileDescriptorGraphql.unions:type_name -> graphqlc.UnionTypeDefinitionDescriptorProto
+	26,  // 8: graphqlc.FileDescriptorGraphql.enums:type_name -> graphqlc.EnumTypeDefinitionDescriptorProto
+	28,  // 9: graphqlc.FileDescriptorGraphql.input_objects:type_name -> graphqlc.InputObjectTypeDefinitionDescriptorProto
+	7,   // 10: graphqlc.FileDescriptorGraphql.operations:type_name -> graphqlc.OperationDescriptorProto
+	9,   // 11: graphqlc.FileDescriptorGraphql.fragments:type_name -> graphqlc.FragmentDescriptorProto
+	6,   // 12: graphqlc.FileDescriptorGraphql.source_code_info:type_name -> graphqlc.SourceCodeInfo
+	49,  // 13: graphqlc.SourceCodeInfo.location:type_name -> graphqlc.SourceCodeInfo.Location
+	2,   // 14: graphqlc.OperationDescriptorProto.operation:type_name -> graphqlc.OperationDescriptorProto.Operation
+	8,   // 15: graphqlc.OperationDescriptorProto.variable_definitions:type_name -> graphqlc.VariableDefinitionDescriptorProto
+	40,  // 16: graphqlc.OperationDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	10,  // 17: graphqlc.OperationDescriptorProto.selection_set:type_name -> graphqlc.SelectionSetDescriptorProto
+	36,  // 18: graphqlc.VariableDefinitionDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
+	42,  // 19: graphqlc.VariableDefinitionDescriptorProto.default_value:type_name -> graphqlc.ValueDescriptorProto
+	40,  // 20: graphqlc.VariableDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 21: graphqlc.FragmentDescriptorProto.type_condition:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 22: graphqlc.FragmentDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	10,  // 23: graphqlc.FragmentDescriptorProto.selection_set:type_name -> graphqlc.SelectionSetDescriptorProto
+	37,  // 24: graphqlc.SelectionSetDescriptorProto.type:type_name -> graphqlc.NamedTypeDescriptorProto
+	11,  // 25: graphqlc.SelectionSetDescriptorProto.selections:type_name -> graphqlc.SelectionDescriptorProto
+	12,  // 26: graphqlc.SelectionDescriptorProto.field:type_name -> graphqlc.FieldSelectionDescriptorProto
+	13,  // 27: graphqlc.SelectionDescriptorProto.fragment_spread:type_name -> graphqlc.FragmentSpreadDescriptorProto
+	14,  // 28: graphqlc.SelectionDescriptorProto.inline_fragment:type_name -> graphqlc.InlineFragmentDescriptorProto
+	41,  // 29: graphqlc.FieldSelectionDescriptorProto.arguments:type_name -> graphqlc.ArgumentDescriptorProto
+	40,  // 30: graphqlc.FieldSelectionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	10,  // 31: graphqlc.FieldSelectionDescriptorProto.selection_set:type_name -> graphqlc.SelectionSetDescriptorProto
+	37,  // 32: graphqlc.FieldSelectionDescriptorProto.parent_type:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 33: graphqlc.FragmentSpreadDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 34: graphqlc.InlineFragmentDescriptorProto.type_condition:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 35: graphqlc.InlineFragmentDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	10,  // 36: graphqlc.InlineFragmentDescriptorProto.selection_set:type_name -> graphqlc.SelectionSetDescriptorProto
+	40,  // 37: graphqlc.SchemaDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 38: graphqlc.SchemaDescriptorProto.query:type_name -> graphqlc.NamedTypeDescriptorProto
+	37,  // 39: graphqlc.SchemaDescriptorProto.mutation:type_name -> graphqlc.NamedTypeDescriptorProto
+	37,  // 40: graphqlc.SchemaDescriptorProto.subscription:type_name -> graphqlc.NamedTypeDescriptorProto
+	35,  // 41: graphqlc.DirectiveDefinitionDescriptorProto.arguments:type_name -> graphqlc.InputValueDefinitionDescriptorProto
+	17,  // 42: graphqlc.DirectiveDefinitionDescriptorProto.locations:type_name -> graphqlc.DirectiveLocationDescriptorProto
+	0,   // 43: graphqlc.DirectiveLocationDescriptorProto.executable_location:type_name -> graphqlc.ExecutableDirectiveLocation
+	1,   // 44: graphqlc.DirectiveLocationDescriptorProto.type_system_location:type_name -> graphqlc.TypeSystemDirectiveLocation
+	40,  // 45: graphqlc.ScalarTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	40,  // 46: graphqlc.ScalarTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	40,  // 47: graphqlc.ObjectTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	34,  // 48: graphqlc.ObjectTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
+	37,  // 49: graphqlc.ObjectTypeDefinitionDescriptorProto.implements:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 50: graphqlc.ObjectTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	34,  // 51: graphqlc.ObjectTypeExtensionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
+	37,  // 52: graphqlc.ObjectTypeExtensionDescriptorProto.implements:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 53: graphqlc.InterfaceTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	34,  // 54: graphqlc.InterfaceTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
+	37,  // 55: graphqlc.InterfaceTypeDefinitionDescriptorProto.implements:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 56: graphqlc.InterfaceTypeExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	34,  // 57: graphqlc.InterfaceTypeExtensionDescriptorProto.fields:type_name -> graphqlc.FieldDefinitionDescriptorProto
+	37,  // 58: graphqlc.InterfaceTypeExtensionDescriptorProto.implements:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 59: graphqlc.UnionTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 60: graphqlc.UnionTypeDefinitionDescriptorProto.member_types:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 61: graphqlc.UnionTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 62: graphqlc.UnionTypeExtensionDefinitionDescriptorProto.member_types:type_name -> graphqlc.NamedTypeDescriptorProto
+	40,  // 63: graphqlc.EnumTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	33,  // 64: graphqlc.EnumTypeDefinitionDescriptorProto.values:type_name -> graphqlc.EnumValueDefinitionDescription
+	40,  // 65: graphqlc.EnumTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	33,  // 66: graphqlc.EnumTypeExtensionDefinitionDescriptorProto.values:type_name -> graphqlc.EnumValueDefinitionDescription
+	40,  // 67: graphqlc.InputObjectTypeDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	35,  // 68: graphqlc.InputObjectTypeDefinitionDescriptorProto.fields:type_name -> graphqlc.InputValueDefinitionDescriptorProto
+	40,  // 69: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	35,  // 70: graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto.fields:type_name -> graphqlc.InputValueDefinitionDescriptorProto
+	31,  // 71: graphqlc.TypeSystemExtensionDescriptorProto.schema_extension:type_name -> graphqlc.SchemaExtensionDescriptorProto
+	32,  // 72: graphqlc.TypeSystemExtensionDescriptorProto.type_extension:type_name -> graphqlc.TypeExtensionDescriptorProto
+	40,  // 73: graphqlc.SchemaExtensionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 74: graphqlc.SchemaExtensionDescriptorProto.query:type_name -> graphqlc.NamedTypeDescriptorProto
+	37,  // 75: graphqlc.SchemaExtensionDescriptorProto.mutation:type_name -> graphqlc.NamedTypeDescriptorProto
+	37,  // 76: graphqlc.SchemaExtensionDescriptorProto.subscription:type_name -> graphqlc.NamedTypeDescriptorProto
+	19,  // 77: graphqlc.TypeExtensionDescriptorProto.scalar_type_extension:type_name -> graphqlc.ScalarTypeExtensionDescriptorProto
+	21,  // 78: graphqlc.TypeExtensionDescriptorProto.object_type_extension:type_name -> graphqlc.ObjectTypeExtensionDescriptorProto
+	23,  // 79: graphqlc.TypeExtensionDescriptorProto.interface_type_extension:type_name -> graphqlc.InterfaceTypeExtensionDescriptorProto
+	25,  // 80: graphqlc.TypeExtensionDescriptorProto.union_type_extension:type_name -> graphqlc.UnionTypeExtensionDefinitionDescriptorProto
+	27,  // 81: graphqlc.TypeExtensionDescriptorProto.enum_type_extions:type_name -> graphqlc.EnumTypeExtensionDefinitionDescriptorProto
+	29,  // 82: graphqlc.TypeExtensionDescriptorProto.input_object_type_extension:type_name -> graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto
+	40,  // 83: graphqlc.EnumValueDefinitionDescription.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	35,  // 84: graphqlc.FieldDefinitionDescriptorProto.arguments:type_name -> graphqlc.InputValueDefinitionDescriptorProto
+	36,  // 85: graphqlc.FieldDefinitionDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
+	40,  // 86: graphqlc.FieldDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	36,  // 87: graphqlc.InputValueDefinitionDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
+	42,  // 88: graphqlc.InputValueDefinitionDescriptorProto.default_value:type_name -> graphqlc.ValueDescriptorProto
+	40,  // 89: graphqlc.InputValueDefinitionDescriptorProto.directives:type_name -> graphqlc.DirectiveDescriptorProto
+	37,  // 90: graphqlc.TypeDescriptorProto.named_type:type_name -> graphqlc.NamedTypeDescriptorProto
+	38,  // 91: graphqlc.TypeDescriptorProto.list_type:type_name -> graphqlc.ListTypeDescriptorProto
+	39,  // 92: graphqlc.TypeDescriptorProto.non_null_type:type_name -> graphqlc.NonNullTypeDescriptorProto
+	3,   // 93: graphqlc.NamedTypeDescriptorProto.kind:type_name -> graphqlc.NamedTypeDescriptorProto.Kind
+	36,  // 94: graphqlc.ListTypeDescriptorProto.type:type_name -> graphqlc.TypeDescriptorProto
+	37,  // 95: graphqlc.NonNullTypeDescriptorProto.named_type:type_name -> graphqlc.NamedTypeDescriptorProto
+	38,  // 96: graphqlc.NonNullTypeDescriptorProto.list_type:type_name -> graphqlc.ListTypeDescriptorProto
+	41,  // 97: graphqlc.DirectiveDescriptorProto.arguments:type_name -> graphqlc.ArgumentDescriptorProto
+	42,  // 98: graphqlc.ArgumentDescriptorProto.value:type_name -> graphqlc.ValueDescriptorProto
+	43,  // 99: graphqlc.ValueDescriptorProto.variable_value:type_name -> graphqlc.VariableDescriptorProto
+	44,  // 100: graphqlc.ValueDescriptorProto.null_value:type_name -> graphqlc.NullValueDescriptorProto
+	45,  // 101: graphqlc.ValueDescriptorProto.enum_value:type_name -> graphqlc.EnumValueDescriptorProto
+	46,  // 102: graphqlc.ValueDescriptorProto.list_value:type_name -> graphqlc.ListValueDescriptorProto
+	47,  // 103: graphqlc.ValueDescriptorProto.object_value:type_name -> graphqlc.ObjectValueDescriptorProto
+	42,  // 104: graphqlc.ListValueDescriptorProto.values:type_name -> graphqlc.ValueDescriptorProto
+	48,  // 105: graphqlc.ObjectValueDescriptorProto.fields:type_name -> graphqlc.ObjectFieldDescriptorProto
+	42,  // 106: graphqlc.ObjectFieldDescriptorProto.value:type_name -> graphqlc.ValueDescriptorProto
+	107, // [107:107] is the sub-list for method output_type
+	107, // [107:107] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
 }
 
 func init() { file_descriptor_proto_init() }
@@ -3390,7 +4739,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchemaDescriptorProto); i {
+			switch v := v.(*SourceCodeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,7 +4751,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectiveDefinitionDescriptorProto); i {
+			switch v := v.(*OperationDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,7 +4763,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectiveLocationDescriptorProto); i {
+			switch v := v.(*VariableDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,7 +4775,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScalarTypeDefinitionDescriptorProto); i {
+			switch v := v.(*FragmentDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,7 +4787,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScalarTypeExtensionDescriptorProto); i {
+			switch v := v.(*SelectionSetDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3450,7 +4799,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectTypeDefinitionDescriptorProto); i {
+			switch v := v.(*SelectionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3462,7 +4811,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectTypeExtensionDescriptorProto); i {
+			switch v := v.(*FieldSelectionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3474,7 +4823,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterfaceTypeDefinitionDescriptorProto); i {
+			switch v := v.(*FragmentSpreadDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3486,7 +4835,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterfaceTypeExtensionDescriptorProto); i {
+			switch v := v.(*InlineFragmentDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3498,7 +4847,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnionTypeDefinitionDescriptorProto); i {
+			switch v := v.(*SchemaDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3510,7 +4859,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnionTypeExtensionDefinitionDescriptorProto); i {
+			switch v := v.(*DirectiveDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3522,7 +4871,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumTypeDefinitionDescriptorProto); i {
+			switch v := v.(*DirectiveLocationDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3534,7 +4883,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumTypeExtensionDefinitionDescriptorProto); i {
+			switch v := v.(*ScalarTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3546,7 +4895,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputObjectTypeDefinitionDescriptorProto); i {
+			switch v := v.(*ScalarTypeExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3558,7 +4907,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputObjectTypeExtensionDefinitionDescriptorProto); i {
+			switch v := v.(*ObjectTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3570,7 +4919,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeSystemExtensionDescriptorProto); i {
+			switch v := v.(*ObjectTypeExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3582,7 +4931,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SchemaExtensionDescriptorProto); i {
+			switch v := v.(*InterfaceTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3594,7 +4943,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeExtensionDescriptorProto); i {
+			switch v := v.(*InterfaceTypeExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3606,7 +4955,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumValueDefinitionDescription); i {
+			switch v := v.(*UnionTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3618,7 +4967,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldDefinitionDescriptorProto); i {
+			switch v := v.(*UnionTypeExtensionDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3630,7 +4979,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputValueDefinitionDescriptorProto); i {
+			switch v := v.(*EnumTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3642,7 +4991,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TypeDescriptorProto); i {
+			switch v := v.(*EnumTypeExtensionDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3654,7 +5003,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NamedTypeDescriptorProto); i {
+			switch v := v.(*InputObjectTypeDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3666,7 +5015,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTypeDescriptorProto); i {
+			switch v := v.(*InputObjectTypeExtensionDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3678,7 +5027,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NonNullTypeDescriptorProto); i {
+			switch v := v.(*TypeSystemExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3690,7 +5039,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectiveDescriptorProto); i {
+			switch v := v.(*SchemaExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3702,7 +5051,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArgumentDescriptorProto); i {
+			switch v := v.(*TypeExtensionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3714,7 +5063,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValueDescriptorProto); i {
+			switch v := v.(*EnumValueDefinitionDescription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3726,7 +5075,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VariableDescriptorProto); i {
+			switch v := v.(*FieldDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3738,7 +5087,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NullValueDescriptorProto); i {
+			switch v := v.(*InputValueDefinitionDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3750,7 +5099,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumValueDescriptorProto); i {
+			switch v := v.(*TypeDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3762,7 +5111,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListValueDescriptorProto); i {
+			switch v := v.(*NamedTypeDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3774,7 +5123,7 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectValueDescriptorProto); i {
+			switch v := v.(*ListTypeDescriptorProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3786,6 +5135,114 @@ func file_descriptor_proto_init() {
 			}
 		}
 		file_descriptor_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NonNullTypeDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectiveDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariableDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NullValueDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumValueDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListValueDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectValueDescriptorProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_descriptor_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ObjectFieldDescriptorProto); i {
 			case 0:
 				return &v.state
@@ -3797,16 +5254,33 @@ func file_descriptor_proto_init() {
 				return nil
 			}
 		}
+		file_descriptor_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceCodeInfo_Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_descriptor_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*SelectionDescriptorProto_Field)(nil),
+		(*SelectionDescriptorProto_FragmentSpread)(nil),
+		(*SelectionDescriptorProto_InlineFragment)(nil),
 	}
-	file_descriptor_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*DirectiveLocationDescriptorProto_ExecutableLocation)(nil),
 		(*DirectiveLocationDescriptorProto_TypeSystemLocation)(nil),
 	}
-	file_descriptor_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*TypeSystemExtensionDescriptorProto_SchemaExtension)(nil),
 		(*TypeSystemExtensionDescriptorProto_TypeExtension)(nil),
 	}
-	file_descriptor_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*TypeExtensionDescriptorProto_ScalarTypeExtension)(nil),
 		(*TypeExtensionDescriptorProto_ObjectTypeExtension)(nil),
 		(*TypeExtensionDescriptorProto_InterfaceTypeExtension)(nil),
@@ -3814,16 +5288,16 @@ func file_descriptor_proto_init() {
 		(*TypeExtensionDescriptorProto_EnumTypeExtions)(nil),
 		(*TypeExtensionDescriptorProto_InputObjectTypeExtension)(nil),
 	}
-	file_descriptor_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*TypeDescriptorProto_NamedType)(nil),
 		(*TypeDescriptorProto_ListType)(nil),
 		(*TypeDescriptorProto_NonNullType)(nil),
 	}
-	file_descriptor_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*NonNullTypeDescriptorProto_NamedType)(nil),
 		(*NonNullTypeDescriptorProto_ListType)(nil),
 	}
-	file_descriptor_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_descriptor_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*ValueDescriptorProto_VariableValue)(nil),
 		(*ValueDescriptorProto_IntValue)(nil),
 		(*ValueDescriptorProto_FloatValue)(nil),
@@ -3833,14 +5307,17 @@ func file_descriptor_proto_init() {
 		(*ValueDescriptorProto_EnumValue)(nil),
 		(*ValueDescriptorProto_ListValue)(nil),
 		(*ValueDescriptorProto_ObjectValue)(nil),
+		(*ValueDescriptorProto_Int64Value)(nil),
+		(*ValueDescriptorProto_DoubleValue)(nil),
+		(*ValueDescriptorProto_BigValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_descriptor_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   36,
+			NumEnums:      4,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
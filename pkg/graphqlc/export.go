@@ -2,6 +2,8 @@ package graphqlc
 
 type DirectiveLocationDescriptorProto_Location = isDirectiveLocationDescriptorProto_Location
 type NonNullTypeDescriptorProto_Type = isNonNullTypeDescriptorProto_Type
+type SelectionDescriptorProto_Selection = isSelectionDescriptorProto_Selection
 type TypeDescriptorProto_Type = isTypeDescriptorProto_Type
+type TypeExtensionDescriptorProto_TypeExtension = isTypeExtensionDescriptorProto_TypeExtension
 type TypeSystemExtensionDescriptorProto_Extension = isTypeSystemExtensionDescriptorProto_Extension
 type ValueDescriptorProto_Value = isValueDescriptorProto_Value
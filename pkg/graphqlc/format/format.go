@@ -0,0 +1,897 @@
+// Package format formats GraphQL documents in a canonical layout. Documents
+// are parsed with the compiler's parser and a formatted document is checked
+// to parse into the same tokens as its source, so formatting never changes
+// what the compiler builds from a document.
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+// Options control the layout of a formatted document.
+type Options struct {
+	// LineLength is the length past which arguments, argument and variable
+	// definitions, union members and directive locations are wrapped one
+	// per line, 80 if zero.
+	LineLength int
+
+	// Sort orders the definitions: the schema first, then directive
+	// definitions, types together with their extensions, operations and
+	// fragments, each by name. A definition comes before the extensions of
+	// what it defines. Comments before the first definition which
+	// are separated from it by a blank line, or which import files, stay at
+	// the top of the document.
+	Sort bool
+}
+
+const (
+	defaultLineLength = 80
+	indentUnit        = "  "
+)
+
+// File formats a parsed document. Comments are kept, a comment on the line
+// a definition ends on stays on that line and every other comment is placed
+// on a line of its own before what follows it. A single blank line is kept
+// where the source has one or more.
+func File(f *parser.File, opts Options) ([]byte, error) {
+	if opts.LineLength <= 0 {
+		opts.LineLength = defaultLineLength
+	}
+	src := f.Document.Loc.Source
+	p := &printer{
+		opts:               opts,
+		body:               src.Body,
+		tokens:             f.Tokens,
+		variableDirectives: f.VariableDirectives,
+	}
+	header, defs, footer := p.split(f)
+	if opts.Sort {
+		sort.SliceStable(defs, func(i, j int) bool {
+			if defs[i].group != defs[j].group {
+				return defs[i].group < defs[j].group
+			}
+			if defs[i].name != defs[j].name {
+				return defs[i].name < defs[j].name
+			}
+			return !defs[i].extension && defs[j].extension
+		})
+	}
+
+	if len(header) > 0 {
+		p.comments, p.next, p.lastEnd = header, 0, header[0].Start
+		p.flushComments(len(p.body))
+	}
+	for i, def := range defs {
+		p.forceBlank = i > 0 || len(header) > 0
+		p.comments, p.next, p.lastEnd = def.comments, 0, def.start
+		if len(def.comments) > 0 && def.comments[0].Start < def.start {
+			p.lastEnd = def.comments[0].Start
+		}
+		p.definition(def.node)
+		p.flushComments(len(p.body))
+	}
+	if len(footer) > 0 {
+		p.comments, p.next = footer, 0
+		p.flushComments(len(p.body))
+	}
+	out := p.buf.Bytes()
+
+	formatted, errs := parser.Parse(source.NewSource(&source.Source{Body: out, Name: src.Name}))
+	if len(errs) > 0 || !sameDocument(f, formatted, opts.Sort) {
+		return nil, fmt.Errorf("%s: formatting would change the document", src.Name)
+	}
+	return out, nil
+}
+
+// definition is a top level definition and the comments printed with it,
+// those before it, in it and on the line it ends on.
+type definition struct {
+	node      ast.Node
+	start     int
+	comments  []parser.Comment
+	group     int
+	name      string
+	extension bool
+}
+
+// split assigns the comments of a document to its definitions. Comments
+// after the last definition are the footer, the header is empty unless the
+// definitions are sorted.
+func (p *printer) split(f *parser.File) (header []parser.Comment, defs []*definition, footer []parser.Comment) {
+	comments := f.Comments
+	for _, node := range f.Document.Definitions {
+		loc := node.GetLoc()
+		def := &definition{node: node, start: loc.Start}
+		def.group, def.name = sortKey(node)
+		_, def.extension = node.(*parser.TypeExtension)
+		end := loc.End
+		n := 0
+		for n < len(comments) && comments[n].Start < end {
+			n++
+		}
+		if n < len(comments) && p.isTrailing(comments[n], end) {
+			n++
+		}
+		def.comments = comments[:n]
+		comments = comments[n:]
+		defs = append(defs, def)
+	}
+	footer = comments
+
+	if p.opts.Sort && len(defs) > 0 {
+		first := defs[0]
+		leading := 0
+		for leading < len(first.comments) && first.comments[leading].Start < first.start {
+			leading++
+		}
+		// The comments directly before the first definition move with it
+		attached, next := leading, first.start
+		for attached > 0 && newlines(p.body[first.comments[attached-1].End:next]) < 2 {
+			attached--
+			next = first.comments[attached].Start
+		}
+		for _, c := range first.comments[attached:leading] {
+			if strings.HasPrefix(strings.TrimSpace(c.Text), "import ") {
+				attached = leading
+				break
+			}
+		}
+		header = first.comments[:attached]
+		first.comments = first.comments[attached:]
+	}
+	return header, defs, footer
+}
+
+func sortKey(node ast.Node) (int, string) {
+	switch def := node.(type) {
+	case *parser.SchemaDefinition:
+		return 0, ""
+	case *parser.DirectiveDefinition:
+		return 1, def.Name.Value
+	case *parser.TypeExtension:
+		if _, ok := def.Definition.(*ast.SchemaDefinition); ok {
+			return 0, ""
+		}
+		return 2, typeName(def.Definition)
+	case *ast.OperationDefinition:
+		if def.Name == nil {
+			return 3, ""
+		}
+		return 3, def.Name.Value
+	case *ast.FragmentDefinition:
+		return 4, def.Name.Value
+	}
+	return 2, typeName(node)
+}
+
+func typeName(node ast.Node) string {
+	switch def := node.(type) {
+	case *ast.ScalarDefinition:
+		return def.Name.Value
+	case *ast.ObjectDefinition:
+		return def.Name.Value
+	case *parser.InterfaceDefinition:
+		return def.Name.Value
+	case *ast.UnionDefinition:
+		return def.Name.Value
+	case *ast.EnumDefinition:
+		return def.Name.Value
+	case *ast.InputObjectDefinition:
+		return def.Name.Value
+	}
+	return ""
+}
+
+// printer prints a document a line at a time. Comments are printed in
+// source order before the first line starting after them.
+type printer struct {
+	opts               Options
+	body               []byte
+	tokens             []parser.Token
+	variableDirectives map[*ast.VariableDefinition][]*ast.Directive
+
+	buf    bytes.Buffer
+	line   strings.Builder // the line being printed
+	indent int
+
+	comments   []parser.Comment // comments of the definition being printed
+	next       int              // first comment not printed
+	lastEnd    int              // end in the source of what was printed last
+	forceBlank bool             // print a blank line before the next line
+	noBlank    bool             // print no blank line before the next line
+}
+
+// flushComments prints the comments before offset before, each on a line of
+// its own.
+func (p *printer) flushComments(before int) {
+	for p.next < len(p.comments) && p.comments[p.next].Start < before {
+		c := p.comments[p.next]
+		p.gap(c.Start)
+		p.buf.WriteString(strings.Repeat(indentUnit, p.indent) + commentText(c))
+		p.buf.WriteByte('\n')
+		p.lastEnd = c.End
+		p.next++
+	}
+}
+
+// gap prints a blank line before a line starting at start if one is forced
+// or the source has one.
+func (p *printer) gap(start int) {
+	switch {
+	case p.buf.Len() == 0:
+	case p.forceBlank:
+		p.buf.WriteByte('\n')
+	case !p.noBlank && p.lastEnd < start && newlines(p.body[p.lastEnd:start]) >= 2:
+		p.buf.WriteByte('\n')
+	}
+	p.forceBlank, p.noBlank = false, false
+}
+
+// startLine starts a line printing what starts at start in the source.
+func (p *printer) startLine(start int) {
+	p.flushComments(start)
+	p.gap(start)
+	p.line.WriteString(strings.Repeat(indentUnit, p.indent))
+}
+
+func (p *printer) write(s string) {
+	p.line.WriteString(s)
+}
+
+// endLine ends a line printing what ends at end in the source, along with
+// the comment following it on the same line, if any.
+func (p *printer) endLine(end int) {
+	if p.next < len(p.comments) && p.isTrailing(p.comments[p.next], end) {
+		c := p.comments[p.next]
+		p.line.WriteString(" " + commentText(c))
+		end = c.End
+		p.next++
+	}
+	p.buf.WriteString(p.line.String())
+	p.buf.WriteByte('\n')
+	p.line.Reset()
+	if end > p.lastEnd {
+		p.lastEnd = end
+	}
+}
+
+// column returns the length of the line being printed.
+func (p *printer) column() int {
+	return utf8.RuneCountInString(p.line.String())
+}
+
+// isTrailing reports whether a comment follows end on the same line with
+// no token in between.
+func (p *printer) isTrailing(c parser.Comment, end int) bool {
+	if c.Start < end || newlines(p.body[end:c.Start]) > 0 {
+		return false
+	}
+	i := sort.Search(len(p.tokens), func(i int) bool { return p.tokens[i].Start >= end })
+	return i == len(p.tokens) || p.tokens[i].Start > c.Start
+}
+
+// commentsWithin reports whether a comment not printed yet starts between
+// start and end.
+func (p *printer) commentsWithin(start, end int) bool {
+	for _, c := range p.comments[p.next:] {
+		if c.Start >= start && c.Start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenBefore returns the last token ending at or before offset.
+func (p *printer) tokenBefore(offset int) parser.Token {
+	i := sort.Search(len(p.tokens), func(i int) bool { return p.tokens[i].End > offset })
+	if i == 0 {
+		return parser.Token{}
+	}
+	return p.tokens[i-1]
+}
+
+// tokenAfter returns the first token starting at or after offset.
+func (p *printer) tokenAfter(offset int) parser.Token {
+	i := sort.Search(len(p.tokens), func(i int) bool { return p.tokens[i].Start >= offset })
+	if i == len(p.tokens) {
+		return parser.Token{Start: len(p.body), End: len(p.body)}
+	}
+	return p.tokens[i]
+}
+
+// headerStart returns where the line of a definition starts, after its
+// description.
+func (p *printer) headerStart(start int, desc *ast.StringValue) int {
+	if desc == nil {
+		return start
+	}
+	return p.tokenAfter(desc.Loc.End).Start
+}
+
+func (p *printer) definition(node ast.Node) {
+	if ext, ok := node.(*parser.TypeExtension); ok {
+		p.typeSystemDefinition(ext.Definition, "extend ", ext.Loc.Start, ext.Loc.End)
+		return
+	}
+	loc := node.GetLoc()
+	switch def := node.(type) {
+	case *ast.OperationDefinition:
+		p.operation(def)
+	case *ast.FragmentDefinition:
+		p.startLine(loc.Start)
+		p.write("fragment " + def.Name.Value + " on " + def.TypeCondition.Name.Value + directivesString(def.Directives))
+		p.selectionSet(def.SelectionSet)
+		p.endLine(loc.End)
+	default:
+		p.typeSystemDefinition(node, "", loc.Start, loc.End)
+	}
+}
+
+// typeSystemDefinition prints a type system definition or, with the prefix
+// "extend ", an extension. start and end include the prefix.
+func (p *printer) typeSystemDefinition(node ast.Node, prefix string, start, end int) {
+	switch def := node.(type) {
+	case *parser.SchemaDefinition:
+		p.description(def.Description)
+		p.schema(def.SchemaDefinition, prefix, p.headerStart(start, def.Description), end)
+	case *ast.SchemaDefinition:
+		p.schema(def, prefix, start, end)
+	case *ast.ScalarDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "scalar " + def.Name.Value + directivesString(def.Directives))
+		p.endLine(end)
+	case *ast.ObjectDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "type " + def.Name.Value + implementsString(def.Interfaces) + directivesString(def.Directives))
+		p.fields(def.Fields, end)
+		p.endLine(end)
+	case *parser.InterfaceDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "interface " + def.Name.Value + implementsString(def.Interfaces) + directivesString(def.Directives))
+		p.fields(def.Fields, end)
+		p.endLine(end)
+	case *ast.UnionDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "union " + def.Name.Value + directivesString(def.Directives))
+		if len(def.Types) == 0 {
+			p.endLine(end)
+			return
+		}
+		names := make([]*ast.Name, len(def.Types))
+		for i, t := range def.Types {
+			names[i] = t.Name
+		}
+		p.pipeList(" =", names, end)
+	case *ast.EnumDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "enum " + def.Name.Value + directivesString(def.Directives))
+		if len(def.Values) > 0 {
+			p.block(" {", def.Values[0].Loc.Start, end, func() {
+				for _, value := range def.Values {
+					p.description(value.Description)
+					p.startLine(p.headerStart(value.Loc.Start, value.Description))
+					p.write(value.Name.Value + directivesString(value.Directives))
+					p.endLine(value.Loc.End)
+				}
+			})
+		}
+		p.endLine(end)
+	case *ast.InputObjectDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write(prefix + "input " + def.Name.Value + directivesString(def.Directives))
+		if len(def.Fields) > 0 {
+			p.block(" {", def.Fields[0].Loc.Start, end, func() {
+				for _, f := range def.Fields {
+					p.description(f.Description)
+					p.startLine(p.headerStart(f.Loc.Start, f.Description))
+					p.write(inputValueString(f))
+					p.endLine(f.Loc.End)
+				}
+			})
+		}
+		p.endLine(end)
+	case *parser.DirectiveDefinition:
+		p.description(def.Description)
+		p.startLine(p.headerStart(start, def.Description))
+		p.write("directive @" + def.Name.Value)
+		rest := " on " + namesString(def.Locations, " | ")
+		if def.Repeatable {
+			rest = " repeatable" + rest
+		}
+		if len(def.Arguments) > 0 {
+			p.inputValueList(def.Arguments, len(rest))
+		}
+		if def.Repeatable {
+			p.write(" repeatable")
+		}
+		p.pipeList(" on", def.Locations, end)
+	}
+}
+
+func (p *printer) schema(def *ast.SchemaDefinition, prefix string, start, end int) {
+	p.startLine(start)
+	p.write(prefix + "schema" + directivesString(def.Directives))
+	if len(def.OperationTypes) > 0 {
+		p.block(" {", def.OperationTypes[0].Loc.Start, end, func() {
+			for _, op := range def.OperationTypes {
+				p.startLine(op.Loc.Start)
+				p.write(op.Operation + ": " + op.Type.Name.Value)
+				p.endLine(op.Loc.End)
+			}
+		})
+	}
+	p.endLine(end)
+}
+
+func (p *printer) fields(fields []*ast.FieldDefinition, end int) {
+	if len(fields) == 0 {
+		return
+	}
+	p.block(" {", fields[0].Loc.Start, end, func() {
+		for _, f := range fields {
+			p.description(f.Description)
+			p.startLine(p.headerStart(f.Loc.Start, f.Description))
+			p.write(f.Name.Value)
+			rest := ": " + typeString(f.Type) + directivesString(f.Directives)
+			if len(f.Arguments) > 0 {
+				p.inputValueList(f.Arguments, len(rest))
+			}
+			p.write(rest)
+			p.endLine(f.Loc.End)
+		}
+	})
+}
+
+// block prints the items of a definition or selection set, one per line
+// between braces. open is printed on the current line, the first item
+// starts at first and the block ends at end in the source.
+func (p *printer) block(open string, first, end int, items func()) {
+	p.write(open)
+	p.endLine(p.tokenBefore(first).End)
+	p.indent++
+	p.noBlank = true
+	items()
+	p.flushComments(end - 1)
+	p.indent--
+	p.noBlank = true
+	p.startLine(end - 1)
+	p.write("}")
+}
+
+// listItem is an element of a parenthesized list, printed on the current
+// line or one per line if the list is wrapped.
+type listItem struct {
+	start, end  int // in the source, the description included
+	description *ast.StringValue
+	text        string
+}
+
+// list prints a parenthesized list, wrapped if the line would be longer
+// than the line length, rest being the length of what follows the list on
+// the line, or if any item has a description or a comment.
+func (p *printer) list(items []listItem, rest int) {
+	closing := p.tokenAfter(items[len(items)-1].end)
+	texts := make([]string, len(items))
+	wrap := p.commentsWithin(items[0].start, closing.Start)
+	for i, item := range items {
+		texts[i] = item.text
+		wrap = wrap || item.description != nil
+	}
+	inline := "(" + strings.Join(texts, ", ") + ")"
+	if !wrap && p.column()+utf8.RuneCountInString(inline)+rest <= p.opts.LineLength {
+		p.write(inline)
+		return
+	}
+
+	p.write("(")
+	p.endLine(p.tokenBefore(items[0].start).End)
+	p.indent++
+	p.noBlank = true
+	for _, item := range items {
+		p.description(item.description)
+		p.startLine(p.headerStart(item.start, item.description))
+		p.write(item.text)
+		p.endLine(item.end)
+	}
+	p.flushComments(closing.Start)
+	p.indent--
+	p.noBlank = true
+	p.startLine(closing.Start)
+	p.write(")")
+}
+
+func (p *printer) inputValueList(values []*ast.InputValueDefinition, rest int) {
+	items := make([]listItem, len(values))
+	for i, value := range values {
+		items[i] = listItem{start: value.Loc.Start, end: value.Loc.End, description: value.Description, text: inputValueString(value)}
+	}
+	p.list(items, rest)
+}
+
+func (p *printer) argumentList(args []*ast.Argument, rest int) {
+	items := make([]listItem, len(args))
+	for i, arg := range args {
+		items[i] = listItem{start: arg.Loc.Start, end: arg.Loc.End, text: arg.Name.Value + ": " + valueString(arg.Value)}
+	}
+	p.list(items, rest)
+}
+
+// pipeList prints union members or directive locations after prefix, on the
+// current line or one per line if the line would be longer than the line
+// length, and ends the line.
+func (p *printer) pipeList(prefix string, names []*ast.Name, end int) {
+	inline := prefix + " " + namesString(names, " | ")
+	if !p.commentsWithin(names[0].Loc.Start, end) && p.column()+utf8.RuneCountInString(inline) <= p.opts.LineLength {
+		p.write(inline)
+		p.endLine(end)
+		return
+	}
+	p.write(prefix)
+	p.endLine(p.tokenBefore(names[0].Loc.Start).End)
+	p.indent++
+	p.noBlank = true
+	for _, name := range names {
+		p.startLine(name.Loc.Start)
+		p.write("| " + name.Value)
+		p.endLine(name.Loc.End)
+	}
+	p.indent--
+}
+
+func (p *printer) operation(def *ast.OperationDefinition) {
+	loc := def.Loc
+	p.startLine(loc.Start)
+	if p.body[loc.Start] == '{' {
+		p.selectionSet(def.SelectionSet)
+		p.endLine(loc.End)
+		return
+	}
+	p.write(def.Operation)
+	if def.Name != nil {
+		p.write(" " + def.Name.Value)
+	}
+	rest := directivesString(def.Directives)
+	if len(def.VariableDefinitions) > 0 {
+		items := make([]listItem, len(def.VariableDefinitions))
+		for i, v := range def.VariableDefinitions {
+			text := "$" + v.Variable.Name.Value + ": " + typeString(v.Type)
+			if v.DefaultValue != nil {
+				text += " = " + valueString(v.DefaultValue)
+			}
+			items[i] = listItem{start: v.Loc.Start, end: v.Loc.End, text: text + directivesString(p.variableDirectives[v])}
+		}
+		p.list(items, len(rest)+len(" {"))
+	}
+	p.write(rest)
+	p.selectionSet(def.SelectionSet)
+	p.endLine(loc.End)
+}
+
+// selectionSet prints a selection set following what is on the current
+// line.
+func (p *printer) selectionSet(set *ast.SelectionSet) {
+	open := " {"
+	if p.column() == len(indentUnit)*p.indent {
+		open = "{"
+	}
+	p.block(open, selectionLoc(set.Selections[0]).Start, set.Loc.End, func() {
+		for _, selection := range set.Selections {
+			p.selection(selection)
+		}
+	})
+}
+
+func (p *printer) selection(selection ast.Selection) {
+	loc := selectionLoc(selection)
+	p.startLine(loc.Start)
+	switch s := selection.(type) {
+	case *ast.Field:
+		if s.Alias != nil {
+			p.write(s.Alias.Value + ": ")
+		}
+		p.write(s.Name.Value)
+		rest := directivesString(s.Directives)
+		if len(s.Arguments) > 0 {
+			open := 0
+			if s.SelectionSet != nil {
+				open = len(" {")
+			}
+			p.argumentList(s.Arguments, len(rest)+open)
+		}
+		p.write(rest)
+		if s.SelectionSet != nil {
+			p.selectionSet(s.SelectionSet)
+		}
+	case *ast.FragmentSpread:
+		p.write("..." + s.Name.Value + directivesString(s.Directives))
+	case *ast.InlineFragment:
+		p.write("...")
+		if s.TypeCondition != nil {
+			p.write(" on " + s.TypeCondition.Name.Value)
+		}
+		p.write(directivesString(s.Directives))
+		p.selectionSet(s.SelectionSet)
+	}
+	p.endLine(loc.End)
+}
+
+// description prints a description on lines of its own, as a block string
+// unless its value cannot be written as one.
+func (p *printer) description(desc *ast.StringValue) {
+	if desc == nil {
+		return
+	}
+	p.startLine(desc.Loc.Start)
+	lines := descriptionLines(desc.Value, strings.Repeat(indentUnit, p.indent))
+	p.write(lines[0])
+	for _, line := range lines[1:] {
+		p.buf.WriteString(p.line.String())
+		p.buf.WriteByte('\n')
+		p.line.Reset()
+		p.write(line)
+	}
+	p.endLine(desc.Loc.End)
+	p.noBlank = true
+}
+
+// descriptionLines returns the lines of a description, the first without
+// indentation. A value on a single line is written """value""", any other
+// value with the quotes on lines of their own. Values which a block string
+// cannot hold, such as one with leading blank lines, are written as strings.
+func descriptionLines(value, indent string) []string {
+	for _, r := range value {
+		if r < 0x20 && r != '\t' && r != '\n' {
+			return []string{quote(value)}
+		}
+	}
+	escaped := strings.ReplaceAll(value, `"""`, `\"""`)
+	if !strings.Contains(value, "\n") && !strings.HasSuffix(value, `"`) && !strings.HasSuffix(value, `\`) &&
+		parser.BlockStringValue(value) == value {
+		return []string{`"""` + escaped + `"""`}
+	}
+
+	rawLines := strings.Split(value, "\n")
+	lines := []string{`"""`}
+	for i, line := range strings.Split(escaped, "\n") {
+		if line != "" {
+			rawLines[i] = indent + rawLines[i]
+			line = indent + line
+		}
+		lines = append(lines, line)
+	}
+	raw := "\n" + strings.Join(rawLines, "\n") + "\n" + indent
+	if parser.BlockStringValue(raw) != value {
+		return []string{quote(value)}
+	}
+	return append(lines, indent+`"""`)
+}
+
+func commentText(c parser.Comment) string {
+	return "#" + strings.TrimRight(c.Text, " \t")
+}
+
+// newlines counts the line terminators of b.
+func newlines(b []byte) int {
+	n := 0
+	for i := 0; i < len(b); i++ {
+		switch b[i] {
+		case '\r':
+			n++
+			if i+1 < len(b) && b[i+1] == '\n' {
+				i++
+			}
+		case '\n':
+			n++
+		}
+	}
+	return n
+}
+
+func inputValueString(value *ast.InputValueDefinition) string {
+	s := value.Name.Value + ": " + typeString(value.Type)
+	if value.DefaultValue != nil {
+		s += " = " + valueString(value.DefaultValue)
+	}
+	return s + directivesString(value.Directives)
+}
+
+func implementsString(interfaces []*ast.Named) string {
+	if len(interfaces) == 0 {
+		return ""
+	}
+	names := make([]*ast.Name, len(interfaces))
+	for i, t := range interfaces {
+		names[i] = t.Name
+	}
+	return " implements " + namesString(names, " & ")
+}
+
+func namesString(names []*ast.Name, sep string) string {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = name.Value
+	}
+	return strings.Join(values, sep)
+}
+
+func directivesString(directives []*ast.Directive) string {
+	var b strings.Builder
+	for _, d := range directives {
+		b.WriteString(" @" + d.Name.Value)
+		if len(d.Arguments) > 0 {
+			b.WriteString("(" + argumentsString(d.Arguments) + ")")
+		}
+	}
+	return b.String()
+}
+
+func argumentsString(args []*ast.Argument) string {
+	values := make([]string, len(args))
+	for i, arg := range args {
+		values[i] = arg.Name.Value + ": " + valueString(arg.Value)
+	}
+	return strings.Join(values, ", ")
+}
+
+func typeString(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.Named:
+		return t.Name.Value
+	case *ast.List:
+		return "[" + typeString(t.Type) + "]"
+	case *ast.NonNull:
+		return typeString(t.Type) + "!"
+	}
+	return ""
+}
+
+func valueString(value ast.Value) string {
+	switch v := value.(type) {
+	case *ast.Variable:
+		return "$" + v.Name.Value
+	case *ast.IntValue:
+		return v.Value
+	case *ast.FloatValue:
+		return v.Value
+	case *ast.StringValue:
+		return quote(v.Value)
+	case *ast.BooleanValue:
+		return strconv.FormatBool(v.Value)
+	case *parser.NullValue:
+		return "null"
+	case *ast.EnumValue:
+		return v.Value
+	case *ast.ListValue:
+		values := make([]string, len(v.Values))
+		for i, item := range v.Values {
+			values[i] = valueString(item)
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	case *ast.ObjectValue:
+		fields := make([]string, len(v.Fields))
+		for i, f := range v.Fields {
+			fields[i] = f.Name.Value + ": " + valueString(f.Value)
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return ""
+}
+
+// quote writes a string value as a GraphQL string.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// sameDocument reports whether two documents have the same definitions,
+// token for token, and the same comments. Strings are compared by value,
+// whether block strings or not, and the optional leading & and | are
+// ignored. Sorted definitions are compared regardless of order.
+func sameDocument(a, b *parser.File, sorted bool) bool {
+	defsA, defsB := definitionTokens(a), definitionTokens(b)
+	commentsA, commentsB := commentTexts(a), commentTexts(b)
+	if sorted {
+		sort.Strings(defsA)
+		sort.Strings(defsB)
+	}
+	sort.Strings(commentsA)
+	sort.Strings(commentsB)
+	return equalStrings(defsA, defsB) && equalStrings(commentsA, commentsB)
+}
+
+func definitionTokens(f *parser.File) []string {
+	var defs []string
+	i := 0
+	for _, node := range f.Document.Definitions {
+		loc := node.GetLoc()
+		var b strings.Builder
+		for ; i < len(f.Tokens) && f.Tokens[i].Start < loc.End; i++ {
+			t := f.Tokens[i]
+			if t.Start < loc.Start {
+				continue
+			}
+			switch t.Kind {
+			case parser.Amp, parser.Pipe:
+				continue
+			case parser.BlockString:
+				t.Kind = parser.String
+			}
+			fmt.Fprintf(&b, "%d%q ", t.Kind, t.Value)
+		}
+		defs = append(defs, b.String())
+	}
+	return defs
+}
+
+func commentTexts(f *parser.File) []string {
+	texts := make([]string, len(f.Comments))
+	for i, c := range f.Comments {
+		texts[i] = strings.TrimRight(c.Text, " \t")
+	}
+	return texts
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func selectionLoc(selection ast.Selection) *ast.Location {
+	switch s := selection.(type) {
+	case *ast.Field:
+		return s.Loc
+	case *ast.FragmentSpread:
+		return s.Loc
+	case *ast.InlineFragment:
+		return s.Loc
+	}
+	return nil
+}
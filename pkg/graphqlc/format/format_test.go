@@ -0,0 +1,52 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc/parser"
+)
+
+func TestFileSort(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "groups by name",
+			src:  "type B { b: Int }\ndirective @d on FIELD\nscalar A\n",
+			want: "directive @d on FIELD\n\nscalar A\n\ntype B {\n  b: Int\n}\n",
+		},
+		{
+			name: "type extension before its type",
+			src:  "extend type A { b: Int }\ntype A { a: Int }\n",
+			want: "type A {\n  a: Int\n}\n\nextend type A {\n  b: Int\n}\n",
+		},
+		{
+			name: "schema extension before the schema",
+			src:  "extend schema { mutation: M }\nschema { query: Q }\n",
+			want: "schema {\n  query: Q\n}\n\nextend schema {\n  mutation: M\n}\n",
+		},
+		{
+			name: "extensions keep their order",
+			src:  "extend type A @b\nextend type A @a\ntype A { a: Int }\n",
+			want: "type A {\n  a: Int\n}\n\nextend type A @b\n\nextend type A @a\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, errs := parser.Parse(source.NewSource(&source.Source{Body: []byte(tt.src), Name: "test.graphql"}))
+			if len(errs) > 0 {
+				t.Fatalf("parse errors %v", errs)
+			}
+			got, err := File(f, Options{Sort: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
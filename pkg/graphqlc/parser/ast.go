@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// The parser builds graphql-go AST nodes, so executable documents can be
+// validated by graphql-go, and the descriptors of the type system. The nodes
+// below wrap or stand in for the ones graphql-go lacks.
+
+const (
+	TypeExtensionKind = "TypeExtension"
+	NullValueKind     = "NullValue"
+)
+
+// TypeExtension is a type system extension, `extend <definition>`.
+// Definition holds the extended definition, its body may be incomplete.
+type TypeExtension struct {
+	Loc        *ast.Location
+	Definition ast.Node
+}
+
+func (ext *TypeExtension) GetKind() string {
+	return TypeExtensionKind
+}
+
+func (ext *TypeExtension) GetLoc() *ast.Location {
+	return ext.Loc
+}
+
+// SchemaDefinition is a schema definition with its description.
+type SchemaDefinition struct {
+	*ast.SchemaDefinition
+	Description *ast.StringValue
+}
+
+// InterfaceDefinition is an interface definition with the interfaces it
+// implements.
+type InterfaceDefinition struct {
+	*ast.InterfaceDefinition
+	Interfaces []*ast.Named
+}
+
+// DirectiveDefinition is a directive definition which may be repeatable.
+type DirectiveDefinition struct {
+	*ast.DirectiveDefinition
+	Repeatable bool
+}
+
+// NullValue is the literal null.
+type NullValue struct {
+	Loc *ast.Location
+}
+
+var _ ast.Value = (*NullValue)(nil)
+
+func (v *NullValue) GetKind() string {
+	return NullValueKind
+}
+
+func (v *NullValue) GetLoc() *ast.Location {
+	return v.Loc
+}
+
+func (v *NullValue) GetValue() interface{} {
+	return nil
+}
@@ -0,0 +1,424 @@
+package parser
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// The parser builds the descriptor of every type system definition and
+// extension as it is parsed, see File.Descriptor. Operations and fragments
+// are left to the compiler, their descriptors refer to the schema.
+
+// addDescriptor adds the descriptor of a definition to the file, if it is a
+// type system definition or extension.
+func (f *File) addDescriptor(node ast.Node) {
+	desc := f.Descriptor
+	switch def := node.(type) {
+	case *SchemaDefinition:
+		// Only the first, a second schema definition is an error left to
+		// the compiler
+		if desc.Schema == nil {
+			desc.Schema = buildSchemaDescriptor(def)
+		}
+	case *ast.ScalarDefinition:
+		desc.Scalars = append(desc.Scalars, buildScalarDescriptor(def))
+	case *ast.ObjectDefinition:
+		desc.Objects = append(desc.Objects, buildObjectDescriptor(def))
+	case *InterfaceDefinition:
+		desc.Interfaces = append(desc.Interfaces, buildInterfaceDescriptor(def))
+	case *ast.UnionDefinition:
+		desc.Unions = append(desc.Unions, buildUnionDescriptor(def))
+	case *ast.EnumDefinition:
+		desc.Enums = append(desc.Enums, buildEnumDescriptor(def))
+	case *ast.InputObjectDefinition:
+		desc.InputObjects = append(desc.InputObjects, buildInputObjectDescriptor(def))
+	case *DirectiveDefinition:
+		desc.Directives = append(desc.Directives, buildDirectiveDefinitionDescriptor(def))
+	case *TypeExtension:
+		desc.TypeExtensions = append(desc.TypeExtensions, buildTypeSystemExtensionDescriptor(def))
+	}
+}
+
+func buildSchemaDescriptor(node *SchemaDefinition) *graphqlc.SchemaDescriptorProto {
+	desc := &graphqlc.SchemaDescriptorProto{
+		Description: description(node.Description),
+		Directives:  DirectiveDescriptors(node.Directives),
+	}
+	desc.Query, desc.Mutation, desc.Subscription = operationTypes(node.OperationTypes)
+	return desc
+}
+
+func buildScalarDescriptor(node *ast.ScalarDefinition) *graphqlc.ScalarTypeDefinitionDescriptorProto {
+	desc := &graphqlc.ScalarTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Directives:  DirectiveDescriptors(node.Directives),
+	}
+	desc.SpecifiedByUrl = SpecifiedByURL(desc.Directives)
+	return desc
+}
+
+func buildObjectDescriptor(node *ast.ObjectDefinition) *graphqlc.ObjectTypeDefinitionDescriptorProto {
+	return &graphqlc.ObjectTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Implements:  namedTypes(node.Interfaces),
+		Directives:  DirectiveDescriptors(node.Directives),
+		Fields:      fieldDescriptors(node.Fields),
+	}
+}
+
+func buildInterfaceDescriptor(node *InterfaceDefinition) *graphqlc.InterfaceTypeDefinitionDescriptorProto {
+	return &graphqlc.InterfaceTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Implements:  namedTypes(node.Interfaces),
+		Directives:  DirectiveDescriptors(node.Directives),
+		Fields:      fieldDescriptors(node.Fields),
+	}
+}
+
+func buildUnionDescriptor(node *ast.UnionDefinition) *graphqlc.UnionTypeDefinitionDescriptorProto {
+	return &graphqlc.UnionTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Directives:  DirectiveDescriptors(node.Directives),
+		MemberTypes: namedTypes(node.Types),
+	}
+}
+
+func buildEnumDescriptor(node *ast.EnumDefinition) *graphqlc.EnumTypeDefinitionDescriptorProto {
+	return &graphqlc.EnumTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Directives:  DirectiveDescriptors(node.Directives),
+		Values:      enumValueDescriptors(node.Values),
+	}
+}
+
+func buildInputObjectDescriptor(node *ast.InputObjectDefinition) *graphqlc.InputObjectTypeDefinitionDescriptorProto {
+	return &graphqlc.InputObjectTypeDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Directives:  DirectiveDescriptors(node.Directives),
+		Fields:      inputValueDescriptors(node.Fields),
+	}
+}
+
+func buildDirectiveDefinitionDescriptor(node *DirectiveDefinition) *graphqlc.DirectiveDefinitionDescriptorProto {
+	desc := &graphqlc.DirectiveDefinitionDescriptorProto{
+		Description: description(node.Description),
+		Name:        node.Name.Value,
+		Arguments:   inputValueDescriptors(node.Arguments),
+		Repeatable:  node.Repeatable,
+	}
+	// The parser only accepts known locations
+	for _, location := range node.Locations {
+		if v, ok := graphqlc.ExecutableDirectiveLocation_value[location.Value]; ok {
+			desc.Locations = append(desc.Locations, &graphqlc.DirectiveLocationDescriptorProto{
+				Location: &graphqlc.DirectiveLocationDescriptorProto_ExecutableLocation{
+					ExecutableLocation: graphqlc.ExecutableDirectiveLocation(v),
+				},
+			})
+		} else {
+			desc.Locations = append(desc.Locations, &graphqlc.DirectiveLocationDescriptorProto{
+				Location: &graphqlc.DirectiveLocationDescriptorProto_TypeSystemLocation{
+					TypeSystemLocation: graphqlc.TypeSystemDirectiveLocation(graphqlc.TypeSystemDirectiveLocation_value[location.Value]),
+				},
+			})
+		}
+	}
+	return desc
+}
+
+func buildTypeSystemExtensionDescriptor(ext *TypeExtension) *graphqlc.TypeSystemExtensionDescriptorProto {
+	var typeExt graphqlc.TypeExtensionDescriptorProto_TypeExtension
+	switch def := ext.Definition.(type) {
+	case *ast.SchemaDefinition:
+		desc := &graphqlc.SchemaExtensionDescriptorProto{
+			Directives: DirectiveDescriptors(def.Directives),
+		}
+		desc.Query, desc.Mutation, desc.Subscription = operationTypes(def.OperationTypes)
+		return &graphqlc.TypeSystemExtensionDescriptorProto{
+			Extension: &graphqlc.TypeSystemExtensionDescriptorProto_SchemaExtension{SchemaExtension: desc},
+		}
+	case *ast.ScalarDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_ScalarTypeExtension{
+			ScalarTypeExtension: &graphqlc.ScalarTypeExtensionDescriptorProto{
+				Name:       def.Name.Value,
+				Directives: DirectiveDescriptors(def.Directives),
+			},
+		}
+	case *ast.ObjectDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_ObjectTypeExtension{
+			ObjectTypeExtension: &graphqlc.ObjectTypeExtensionDescriptorProto{
+				Name:       def.Name.Value,
+				Implements: namedTypes(def.Interfaces),
+				Directives: DirectiveDescriptors(def.Directives),
+				Fields:     fieldDescriptors(def.Fields),
+			},
+		}
+	case *InterfaceDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_InterfaceTypeExtension{
+			InterfaceTypeExtension: &graphqlc.InterfaceTypeExtensionDescriptorProto{
+				Name:       def.Name.Value,
+				Implements: namedTypes(def.Interfaces),
+				Directives: DirectiveDescriptors(def.Directives),
+				Fields:     fieldDescriptors(def.Fields),
+			},
+		}
+	case *ast.UnionDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension{
+			UnionTypeExtension: &graphqlc.UnionTypeExtensionDefinitionDescriptorProto{
+				Name:        def.Name.Value,
+				Directives:  DirectiveDescriptors(def.Directives),
+				MemberTypes: namedTypes(def.Types),
+			},
+		}
+	case *ast.EnumDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions{
+			EnumTypeExtions: &graphqlc.EnumTypeExtensionDefinitionDescriptorProto{
+				Name:       def.Name.Value,
+				Directives: DirectiveDescriptors(def.Directives),
+				Values:     enumValueDescriptors(def.Values),
+			},
+		}
+	case *ast.InputObjectDefinition:
+		typeExt = &graphqlc.TypeExtensionDescriptorProto_InputObjectTypeExtension{
+			InputObjectTypeExtension: &graphqlc.InputObjectTypeExtensionDefinitionDescriptorProto{
+				Name:       def.Name.Value,
+				Directives: DirectiveDescriptors(def.Directives),
+				Fields:     inputValueDescriptors(def.Fields),
+			},
+		}
+	}
+	return &graphqlc.TypeSystemExtensionDescriptorProto{
+		Extension: &graphqlc.TypeSystemExtensionDescriptorProto_TypeExtension{
+			TypeExtension: &graphqlc.TypeExtensionDescriptorProto{TypeExtension: typeExt},
+		},
+	}
+}
+
+func operationTypes(nodes []*ast.OperationTypeDefinition) (query, mutation, subscription *graphqlc.NamedTypeDescriptorProto) {
+	for _, node := range nodes {
+		typeRef := &graphqlc.NamedTypeDescriptorProto{Name: node.Type.Name.Value}
+		switch node.Operation {
+		case "query":
+			query = typeRef
+		case "mutation":
+			mutation = typeRef
+		case "subscription":
+			subscription = typeRef
+		}
+	}
+	return query, mutation, subscription
+}
+
+func namedTypes(nodes []*ast.Named) []*graphqlc.NamedTypeDescriptorProto {
+	var descs []*graphqlc.NamedTypeDescriptorProto
+	for _, node := range nodes {
+		descs = append(descs, &graphqlc.NamedTypeDescriptorProto{Name: node.Name.Value})
+	}
+	return descs
+}
+
+func fieldDescriptors(nodes []*ast.FieldDefinition) []*graphqlc.FieldDefinitionDescriptorProto {
+	var descs []*graphqlc.FieldDefinitionDescriptorProto
+	for _, node := range nodes {
+		descs = append(descs, &graphqlc.FieldDefinitionDescriptorProto{
+			Description: description(node.Description),
+			Name:        node.Name.Value,
+			Arguments:   inputValueDescriptors(node.Arguments),
+			Type:        TypeDescriptor(node.Type),
+			Directives:  DirectiveDescriptors(node.Directives),
+		})
+	}
+	return descs
+}
+
+func inputValueDescriptors(nodes []*ast.InputValueDefinition) []*graphqlc.InputValueDefinitionDescriptorProto {
+	var descs []*graphqlc.InputValueDefinitionDescriptorProto
+	for _, node := range nodes {
+		desc := &graphqlc.InputValueDefinitionDescriptorProto{
+			Description: description(node.Description),
+			Name:        node.Name.Value,
+			Type:        TypeDescriptor(node.Type),
+			Directives:  DirectiveDescriptors(node.Directives),
+		}
+		if node.DefaultValue != nil {
+			desc.DefaultValue = ValueDescriptor(node.DefaultValue)
+		}
+		descs = append(descs, desc)
+	}
+	return descs
+}
+
+func enumValueDescriptors(nodes []*ast.EnumValueDefinition) []*graphqlc.EnumValueDefinitionDescription {
+	var descs []*graphqlc.EnumValueDefinitionDescription
+	for _, node := range nodes {
+		descs = append(descs, &graphqlc.EnumValueDefinitionDescription{
+			Description: description(node.Description),
+			Value:       node.Name.Value,
+			Directives:  DirectiveDescriptors(node.Directives),
+		})
+	}
+	return descs
+}
+
+func description(node *ast.StringValue) string {
+	if node == nil {
+		return ""
+	}
+	return node.Value
+}
+
+// TypeDescriptor returns the descriptor of a type reference.
+func TypeDescriptor(typ ast.Type) *graphqlc.TypeDescriptorProto {
+	switch typ := typ.(type) {
+	case *ast.Named:
+		return &graphqlc.TypeDescriptorProto{
+			Type: &graphqlc.TypeDescriptorProto_NamedType{
+				NamedType: &graphqlc.NamedTypeDescriptorProto{Name: typ.Name.Value},
+			},
+		}
+	case *ast.List:
+		return &graphqlc.TypeDescriptorProto{
+			Type: &graphqlc.TypeDescriptorProto_ListType{
+				ListType: &graphqlc.ListTypeDescriptorProto{Type: TypeDescriptor(typ.Type)},
+			},
+		}
+	case *ast.NonNull:
+		nonNull := &graphqlc.NonNullTypeDescriptorProto{}
+		switch typ := typ.Type.(type) {
+		case *ast.Named:
+			nonNull.Type = &graphqlc.NonNullTypeDescriptorProto_NamedType{
+				NamedType: &graphqlc.NamedTypeDescriptorProto{Name: typ.Name.Value},
+			}
+		case *ast.List:
+			nonNull.Type = &graphqlc.NonNullTypeDescriptorProto_ListType{
+				ListType: &graphqlc.ListTypeDescriptorProto{Type: TypeDescriptor(typ.Type)},
+			}
+		}
+		return &graphqlc.TypeDescriptorProto{
+			Type: &graphqlc.TypeDescriptorProto_NonNullType{NonNullType: nonNull},
+		}
+	}
+	return nil
+}
+
+// DirectiveDescriptors returns the descriptors of the directives used on a
+// definition.
+func DirectiveDescriptors(nodes []*ast.Directive) []*graphqlc.DirectiveDescriptorProto {
+	var descs []*graphqlc.DirectiveDescriptorProto
+	for _, node := range nodes {
+		desc := &graphqlc.DirectiveDescriptorProto{Name: node.Name.Value}
+		for _, argument := range node.Arguments {
+			desc.Arguments = append(desc.Arguments, ArgumentDescriptor(argument))
+		}
+		descs = append(descs, desc)
+	}
+	return descs
+}
+
+// SpecifiedByURL returns the url argument of a @specifiedBy directive.
+func SpecifiedByURL(directives []*graphqlc.DirectiveDescriptorProto) string {
+	for _, directive := range directives {
+		if directive.Name != "specifiedBy" {
+			continue
+		}
+		for _, arg := range directive.Arguments {
+			if arg.Name == "url" {
+				return arg.Value.GetStringValue()
+			}
+		}
+	}
+	return ""
+}
+
+// ArgumentDescriptor returns the descriptor of an argument of a field or a
+// directive.
+func ArgumentDescriptor(node *ast.Argument) *graphqlc.ArgumentDescriptorProto {
+	return &graphqlc.ArgumentDescriptorProto{
+		Name:  node.Name.Value,
+		Value: ValueDescriptor(node.Value),
+	}
+}
+
+// ValueDescriptor returns the descriptor of a value, keeping its source
+// text.
+func ValueDescriptor(value ast.Value) *graphqlc.ValueDescriptorProto {
+	desc := &graphqlc.ValueDescriptorProto{Raw: RawValue(value)}
+	switch value := value.(type) {
+	case *ast.Variable:
+		desc.Value = &graphqlc.ValueDescriptorProto_VariableValue{
+			VariableValue: &graphqlc.VariableDescriptorProto{Name: value.Name.Value},
+		}
+	case *ast.IntValue:
+		desc.Value = IntValue(value.Value)
+	case *ast.FloatValue:
+		desc.Value = FloatValue(value.Value)
+	case *ast.StringValue:
+		desc.Value = &graphqlc.ValueDescriptorProto_StringValue{StringValue: value.Value}
+	case *ast.BooleanValue:
+		desc.Value = &graphqlc.ValueDescriptorProto_BooleanValue{BooleanValue: value.Value}
+	case *NullValue:
+		desc.Value = &graphqlc.ValueDescriptorProto_NullValue{NullValue: &graphqlc.NullValueDescriptorProto{}}
+	case *ast.EnumValue:
+		desc.Value = &graphqlc.ValueDescriptorProto_EnumValue{
+			EnumValue: &graphqlc.EnumValueDescriptorProto{Value: value.Value},
+		}
+	case *ast.ListValue:
+		list := &graphqlc.ListValueDescriptorProto{}
+		for _, v := range value.Values {
+			list.Values = append(list.Values, ValueDescriptor(v))
+		}
+		desc.Value = &graphqlc.ValueDescriptorProto_ListValue{ListValue: list}
+	case *ast.ObjectValue:
+		object := &graphqlc.ObjectValueDescriptorProto{}
+		for _, field := range value.Fields {
+			object.Fields = append(object.Fields, &graphqlc.ObjectFieldDescriptorProto{
+				Name:  field.Name.Value,
+				Value: ValueDescriptor(field.Value),
+			})
+		}
+		desc.Value = &graphqlc.ValueDescriptorProto_ObjectValue{ObjectValue: object}
+	}
+	return desc
+}
+
+// RawValue returns the source text of a value.
+func RawValue(value ast.Value) string {
+	loc := value.GetLoc()
+	if loc == nil || loc.Source == nil {
+		return ""
+	}
+	return string(loc.Source.Body[loc.Start:loc.End])
+}
+
+// IntValue returns the narrowest representation of an Int literal which
+// holds it exactly.
+func IntValue(literal string) graphqlc.ValueDescriptorProto_Value {
+	if v, err := strconv.ParseInt(literal, 10, 32); err == nil {
+		return &graphqlc.ValueDescriptorProto_IntValue{IntValue: int32(v)}
+	}
+	if v, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return &graphqlc.ValueDescriptorProto_Int64Value{Int64Value: v}
+	}
+	return &graphqlc.ValueDescriptorProto_BigValue{BigValue: literal}
+}
+
+// FloatValue returns the narrowest representation of a Float literal which
+// holds it as exactly as float64 does.
+func FloatValue(literal string) graphqlc.ValueDescriptorProto_Value {
+	v, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		// Overflows or underflows float64
+		return &graphqlc.ValueDescriptorProto_BigValue{BigValue: literal}
+	}
+	if float64(float32(v)) == v {
+		return &graphqlc.ValueDescriptorProto_FloatValue{FloatValue: float32(v)}
+	}
+	return &graphqlc.ValueDescriptorProto_DoubleValue{DoubleValue: v}
+}
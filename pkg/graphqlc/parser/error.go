@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+)
+
+// Error is a syntax error.
+type Error struct {
+	Loc     *ast.Location
+	Message string
+}
+
+func (e *Error) Error() string {
+	l := location.GetLocation(e.Loc.Source, e.Loc.Start)
+	return fmt.Sprintf("%s:%d:%d: %s", e.Loc.Source.Name, l.Line, l.Column, e.Message)
+}
@@ -0,0 +1,455 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// byteOrderMark is the UTF-8 encoding of U+FEFF.
+var byteOrderMark = []byte("\uFEFF")
+
+// lexer splits a source into tokens, recording every token and comment.
+// Invalid input is reported and skipped, lexing continues after it.
+type lexer struct {
+	source   *source.Source
+	body     []byte
+	pos      int
+	tokens   []Token
+	comments []Comment
+	errs     []*Error
+}
+
+func newLexer(src *source.Source) *lexer {
+	return &lexer{source: src, body: src.Body}
+}
+
+func (l *lexer) errorf(start, end int, format string, args ...interface{}) {
+	l.errs = append(l.errs, &Error{
+		Loc:     &ast.Location{Start: start, End: end, Source: l.source},
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// next returns the next token, an EOF token at the end of the source.
+func (l *lexer) next() Token {
+	token := l.lex()
+	if token.Kind != EOF {
+		l.tokens = append(l.tokens, token)
+	}
+	return token
+}
+
+func (l *lexer) lex() Token {
+	body := l.body
+	for l.pos < len(body) {
+		start := l.pos
+		r, size := utf8.DecodeRune(body[start:])
+		switch {
+		case r == '\uFEFF' || r == ' ' || r == '\t' || r == ',' || r == '\n' || r == '\r':
+			l.pos += size
+		case r == '#':
+			l.readComment()
+		case strings.ContainsRune("!$&():=@[]{|}", r):
+			l.pos++
+			return Token{Kind: punctuators[r], Start: start, End: l.pos}
+		case r == '.':
+			if strings.HasPrefix(string(body[start:]), "...") {
+				l.pos += 3
+				return Token{Kind: Spread, Start: start, End: l.pos}
+			}
+			l.pos++
+			l.errorf(start, l.pos, "unexpected character \".\", did you mean \"...\"?")
+		case isNameStart(r):
+			return l.readName()
+		case r == '-' || isDigit(r):
+			return l.readNumber()
+		case r == '"':
+			if strings.HasPrefix(string(body[start:]), `"""`) {
+				return l.readBlockString()
+			}
+			return l.readString()
+		case r == utf8.RuneError && size == 1:
+			l.pos++
+			l.errorf(start, l.pos, "invalid UTF-8 encoding")
+		default:
+			l.pos += size
+			l.errorf(start, l.pos, "unexpected character %s", describeRune(r))
+		}
+	}
+	return Token{Kind: EOF, Start: len(body), End: len(body)}
+}
+
+var punctuators = map[rune]TokenKind{
+	'!': Bang,
+	'$': Dollar,
+	'&': Amp,
+	'(': ParenL,
+	')': ParenR,
+	':': Colon,
+	'=': Equals,
+	'@': At,
+	'[': BracketL,
+	']': BracketR,
+	'{': BraceL,
+	'|': Pipe,
+	'}': BraceR,
+}
+
+func (l *lexer) readComment() {
+	start := l.pos
+	for l.pos < len(l.body) && l.body[l.pos] != '\n' && l.body[l.pos] != '\r' {
+		l.pos++
+	}
+	l.comments = append(l.comments, Comment{
+		Start: start,
+		End:   l.pos,
+		Text:  string(l.body[start+1 : l.pos]),
+	})
+}
+
+/**
+ * Name :: NameStart NameContinue* [lookahead != NameContinue]
+ */
+func (l *lexer) readName() Token {
+	start := l.pos
+	for l.pos < len(l.body) && isNameContinue(rune(l.body[l.pos])) {
+		l.pos++
+	}
+	return Token{Kind: Name, Start: start, End: l.pos, Value: string(l.body[start:l.pos])}
+}
+
+/**
+ * IntValue :: IntegerPart [lookahead != {Digit, `.`, NameStart}]
+ *
+ * FloatValue ::
+ *   - IntegerPart FractionalPart ExponentPart [lookahead != {Digit, `.`, NameStart}]
+ *   - IntegerPart FractionalPart [lookahead != {Digit, `.`, NameStart}]
+ *   - IntegerPart ExponentPart [lookahead != {Digit, `.`, NameStart}]
+ *
+ * A malformed number is reported and returned whole, so parsing continues
+ * after it.
+ */
+func (l *lexer) readNumber() Token {
+	start := l.pos
+	kind := Int
+	ok := true
+	if l.peekByte() == '-' {
+		l.pos++
+	}
+	if l.peekByte() == '0' {
+		l.pos++
+		if isDigit(rune(l.peekByte())) {
+			l.errorf(l.pos, l.pos+1, "invalid number, unexpected digit after 0: %s", describeRune(rune(l.peekByte())))
+			l.readDigits()
+			ok = false
+		}
+	} else if !l.readDigits() {
+		ok = false
+	}
+	if l.peekByte() == '.' {
+		kind = Float
+		l.pos++
+		if !l.readDigits() {
+			ok = false
+		}
+	}
+	if b := l.peekByte(); b == 'e' || b == 'E' {
+		kind = Float
+		l.pos++
+		if b := l.peekByte(); b == '+' || b == '-' {
+			l.pos++
+		}
+		if !l.readDigits() {
+			ok = false
+		}
+	}
+	if b := rune(l.peekByte()); ok && (b == '.' || isNameStart(b)) {
+		l.errorf(l.pos, l.pos+1, "invalid number, expected digit but got %s", describeRune(b))
+		ok = false
+	}
+	if !ok {
+		for l.pos < len(l.body) && (isNameContinue(rune(l.body[l.pos])) || l.body[l.pos] == '.') {
+			l.pos++
+		}
+	}
+	return Token{Kind: kind, Start: start, End: l.pos, Value: string(l.body[start:l.pos])}
+}
+
+// readDigits reads one or more digits, reporting whether there were any.
+func (l *lexer) readDigits() bool {
+	if !isDigit(rune(l.peekByte())) {
+		if l.pos < len(l.body) {
+			r, _ := utf8.DecodeRune(l.body[l.pos:])
+			l.errorf(l.pos, l.pos+1, "invalid number, expected digit but got %s", describeRune(r))
+		} else {
+			l.errorf(l.pos, l.pos, "invalid number, expected digit but got <EOF>")
+		}
+		return false
+	}
+	for isDigit(rune(l.peekByte())) {
+		l.pos++
+	}
+	return true
+}
+
+func (l *lexer) peekByte() byte {
+	if l.pos < len(l.body) {
+		return l.body[l.pos]
+	}
+	return 0
+}
+
+/**
+ * StringValue :: `"` StringCharacter* `"`
+ *
+ * StringCharacter ::
+ *   - SourceCharacter but not `"` or \ or LineTerminator
+ *   - \u EscapedUnicode
+ *   - \ EscapedCharacter
+ */
+func (l *lexer) readString() Token {
+	start := l.pos
+	l.pos++
+	var value strings.Builder
+	for {
+		if l.pos >= len(l.body) || l.body[l.pos] == '\n' || l.body[l.pos] == '\r' {
+			l.errorf(start, l.pos, "unterminated string")
+			break
+		}
+		r, size := utf8.DecodeRune(l.body[l.pos:])
+		if r == '"' {
+			l.pos++
+			break
+		}
+		if r != '\\' {
+			l.checkSourceCharacter(r, size)
+			value.WriteRune(r)
+			l.pos += size
+			continue
+		}
+
+		escape := l.pos
+		l.pos++
+		if l.pos >= len(l.body) {
+			continue
+		}
+		c := l.body[l.pos]
+		switch c {
+		case '"', '\\', '/':
+			value.WriteByte(c)
+			l.pos++
+		case 'b':
+			value.WriteByte('\b')
+			l.pos++
+		case 'f':
+			value.WriteByte('\f')
+			l.pos++
+		case 'n':
+			value.WriteByte('\n')
+			l.pos++
+		case 'r':
+			value.WriteByte('\r')
+			l.pos++
+		case 't':
+			value.WriteByte('\t')
+			l.pos++
+		case 'u':
+			r, ok := l.readEscapedUnicode()
+			if !ok {
+				l.errorf(escape, l.pos, "invalid Unicode escape sequence \"%s\"", string(l.body[escape:l.pos]))
+				continue
+			}
+			// A surrogate pair is two escapes
+			if utf16IsHighSurrogate(r) {
+				save := l.pos
+				if strings.HasPrefix(string(l.body[l.pos:]), `\u`) {
+					l.pos++
+					if low, ok := l.readEscapedUnicode(); ok && utf16IsLowSurrogate(low) {
+						value.WriteRune(combineSurrogates(r, low))
+						continue
+					}
+				}
+				l.pos = save
+			}
+			if utf16IsHighSurrogate(r) || utf16IsLowSurrogate(r) {
+				l.errorf(escape, l.pos, "invalid Unicode escape sequence \"%s\", unpaired surrogate", string(l.body[escape:l.pos]))
+				continue
+			}
+			value.WriteRune(r)
+		default:
+			_, size := utf8.DecodeRune(l.body[l.pos:])
+			l.pos += size
+			l.errorf(escape, l.pos, "invalid escape sequence \"%s\"", string(l.body[escape:l.pos]))
+		}
+	}
+	return Token{Kind: String, Start: start, End: l.pos, Value: value.String()}
+}
+
+// readEscapedUnicode reads the four hexadecimal digits after \u.
+func (l *lexer) readEscapedUnicode() (rune, bool) {
+	l.pos++ // u
+	end := l.pos + 4
+	if end > len(l.body) {
+		end = len(l.body)
+	}
+	digits := string(l.body[l.pos:end])
+	n := 0
+	for n < len(digits) && isHexDigit(digits[n]) {
+		n++
+	}
+	l.pos += n
+	if n != 4 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(digits, 16, 32)
+	return rune(v), err == nil
+}
+
+/**
+ * BlockString :: `"""` BlockStringCharacter* `"""`
+ *
+ * BlockStringCharacter ::
+ *   - SourceCharacter but not `"""` or `\"""`
+ *   - `\"""`
+ */
+func (l *lexer) readBlockString() Token {
+	start := l.pos
+	l.pos += 3
+	var raw strings.Builder
+	for {
+		if l.pos >= len(l.body) {
+			l.errorf(start, l.pos, "unterminated block string")
+			break
+		}
+		rest := string(l.body[l.pos:])
+		if strings.HasPrefix(rest, `"""`) {
+			l.pos += 3
+			break
+		}
+		if strings.HasPrefix(rest, `\"""`) {
+			raw.WriteString(`"""`)
+			l.pos += 4
+			continue
+		}
+		r, size := utf8.DecodeRune(l.body[l.pos:])
+		if r != '\n' && r != '\r' {
+			l.checkSourceCharacter(r, size)
+		}
+		raw.WriteRune(r)
+		l.pos += size
+	}
+	return Token{Kind: BlockString, Start: start, End: l.pos, Value: BlockStringValue(raw.String())}
+}
+
+// checkSourceCharacter reports characters which may not appear in a string,
+// the control characters other than tab and invalid UTF-8.
+func (l *lexer) checkSourceCharacter(r rune, size int) {
+	switch {
+	case r == utf8.RuneError && size == 1:
+		l.errorf(l.pos, l.pos+1, "invalid UTF-8 encoding")
+	case r < 0x20 && r != '\t':
+		l.errorf(l.pos, l.pos+size, "invalid character within string %s", describeRune(r))
+	}
+}
+
+// BlockStringValue returns the value of a block string given its raw
+// contents, the common indentation and leading and trailing blank lines
+// removed.
+func BlockStringValue(raw string) string {
+	lines := splitLines(raw)
+
+	commonIndent := -1
+	for _, line := range lines[1:] {
+		indent := leadingWhitespace(line)
+		if indent < len(line) && (commonIndent < 0 || indent < commonIndent) {
+			commonIndent = indent
+		}
+	}
+	if commonIndent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) < commonIndent {
+				lines[i] = ""
+			} else {
+				lines[i] = lines[i][commonIndent:]
+			}
+		}
+	}
+
+	for len(lines) > 0 && leadingWhitespace(lines[0]) == len(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && leadingWhitespace(lines[len(lines)-1]) == len(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// splitLines splits s at every line terminator, \r\n, \n or \r.
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\r':
+			lines = append(lines, s[start:i])
+			if i+1 < len(s) && s[i+1] == '\n' {
+				i++
+			}
+			start = i + 1
+		case '\n':
+			lines = append(lines, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(lines, s[start:])
+}
+
+func leadingWhitespace(s string) int {
+	i := 0
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+		i++
+	}
+	return i
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || 'A' <= r && r <= 'Z' || 'a' <= r && r <= 'z'
+}
+
+func isNameContinue(r rune) bool {
+	return isNameStart(r) || isDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func isHexDigit(b byte) bool {
+	return '0' <= b && b <= '9' || 'a' <= b && b <= 'f' || 'A' <= b && b <= 'F'
+}
+
+func utf16IsHighSurrogate(r rune) bool {
+	return 0xd800 <= r && r <= 0xdbff
+}
+
+func utf16IsLowSurrogate(r rune) bool {
+	return 0xdc00 <= r && r <= 0xdfff
+}
+
+func combineSurrogates(high, low rune) rune {
+	return (high-0xd800)<<10 + (low - 0xdc00) + 0x10000
+}
+
+// describeRune formats a character for error messages, printable ones
+// quoted and others as their code point.
+func describeRune(r rune) string {
+	if r < 0x20 || r == 0x7f {
+		return fmt.Sprintf("%U", r)
+	}
+	return strconv.QuoteRune(r)
+}
@@ -0,0 +1,186 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// lexedToken is a token without its position.
+type lexedToken struct {
+	Kind  TokenKind
+	Value string
+}
+
+// lexAll returns the tokens of body and the errors found lexing it.
+func lexAll(body string) ([]lexedToken, []string) {
+	l := newLexer(source.NewSource(&source.Source{Body: []byte(body), Name: "test.graphql"}))
+	var tokens []lexedToken
+	for token := l.next(); token.Kind != EOF; token = l.next() {
+		tokens = append(tokens, lexedToken{token.Kind, token.Value})
+	}
+	return tokens, errorStrings(l.errs)
+}
+
+func errorStrings(errs []*Error) []string {
+	var strs []string
+	for _, err := range errs {
+		strs = append(strs, err.Error())
+	}
+	return strs
+}
+
+func TestLexer(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []lexedToken
+		wantErr []string
+	}{
+		{
+			name: "punctuators",
+			body: "! $ & ( ) ... : = @ [ ] { | }",
+			want: []lexedToken{
+				{Bang, ""}, {Dollar, ""}, {Amp, ""}, {ParenL, ""}, {ParenR, ""}, {Spread, ""}, {Colon, ""},
+				{Equals, ""}, {At, ""}, {BracketL, ""}, {BracketR, ""}, {BraceL, ""}, {Pipe, ""}, {BraceR, ""},
+			},
+		},
+		{
+			name: "ignored tokens",
+			body: "\uFEFFa,\tb # comment\r\n_c1",
+			want: []lexedToken{{Name, "a"}, {Name, "b"}, {Name, "_c1"}},
+		},
+		{
+			name: "numbers",
+			body: "0 -1 42 1.5 -0.25 1e10 1.5E-3 2e+2",
+			want: []lexedToken{
+				{Int, "0"}, {Int, "-1"}, {Int, "42"}, {Float, "1.5"}, {Float, "-0.25"},
+				{Float, "1e10"}, {Float, "1.5E-3"}, {Float, "2e+2"},
+			},
+		},
+		{
+			name:    "leading zero",
+			body:    "01",
+			want:    []lexedToken{{Int, "01"}},
+			wantErr: []string{`test.graphql:1:2: invalid number, unexpected digit after 0: '1'`},
+		},
+		{
+			name:    "missing fraction",
+			body:    "1. a",
+			want:    []lexedToken{{Float, "1."}, {Name, "a"}},
+			wantErr: []string{`test.graphql:1:3: invalid number, expected digit but got ' '`},
+		},
+		{
+			name:    "name after number",
+			body:    "1x",
+			want:    []lexedToken{{Int, "1x"}},
+			wantErr: []string{`test.graphql:1:2: invalid number, expected digit but got 'x'`},
+		},
+		{
+			name: "strings",
+			body: `"" "a b" "\"\\\/\b\f\n\r\t" "é😀"`,
+			want: []lexedToken{{String, ""}, {String, "a b"}, {String, "\"\\/\b\f\n\r\t"}, {String, "é😀"}},
+		},
+		{
+			name: "invalid escapes",
+			body: `"\x" "\u12" "\uD83D"`,
+			want: []lexedToken{{String, ""}, {String, ""}, {String, ""}},
+			wantErr: []string{
+				`test.graphql:1:2: invalid escape sequence "\x"`,
+				`test.graphql:1:7: invalid Unicode escape sequence "\u12"`,
+				`test.graphql:1:14: invalid Unicode escape sequence "\uD83D", unpaired surrogate`,
+			},
+		},
+		{
+			name:    "unterminated string",
+			body:    "\"abc\nd",
+			want:    []lexedToken{{String, "abc"}, {Name, "d"}},
+			wantErr: []string{`test.graphql:1:1: unterminated string`},
+		},
+		{
+			name:    "control character in string",
+			body:    "\"a\x01\"",
+			want:    []lexedToken{{String, "a\x01"}},
+			wantErr: []string{`test.graphql:1:3: invalid character within string U+0001`},
+		},
+		{
+			name: "block strings",
+			body: "\"\"\"\n    a\n      b\n\n    c \\\"\"\" d\n  \"\"\" \"\"\"\"\"\"",
+			want: []lexedToken{{BlockString, "a\n  b\n\nc \"\"\" d"}, {BlockString, ""}},
+		},
+		{
+			name:    "unterminated block string",
+			body:    `a """b`,
+			want:    []lexedToken{{Name, "a"}, {BlockString, "b"}},
+			wantErr: []string{`test.graphql:1:3: unterminated block string`},
+		},
+		{
+			name:    "CRLF",
+			body:    "a\r\n\"\"\"\r\n  b\r\n    c\r\n\"\"\"\r\n?",
+			want:    []lexedToken{{Name, "a"}, {BlockString, "b\n  c"}},
+			wantErr: []string{`test.graphql:6:1: unexpected character '?'`},
+		},
+		{
+			name:    "CR",
+			body:    "a\r\rb?",
+			want:    []lexedToken{{Name, "a"}, {Name, "b"}},
+			wantErr: []string{`test.graphql:3:2: unexpected character '?'`},
+		},
+		{
+			name: "unexpected characters",
+			body: "a . .. ? \x00 \xff",
+			want: []lexedToken{{Name, "a"}},
+			wantErr: []string{
+				`test.graphql:1:3: unexpected character ".", did you mean "..."?`,
+				`test.graphql:1:5: unexpected character ".", did you mean "..."?`,
+				`test.graphql:1:6: unexpected character ".", did you mean "..."?`,
+				`test.graphql:1:8: unexpected character '?'`,
+				`test.graphql:1:10: unexpected character U+0000`,
+				`test.graphql:1:12: invalid UTF-8 encoding`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErr := lexAll(tt.body)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got tokens %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("got errors %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLexerComments(t *testing.T) {
+	l := newLexer(source.NewSource(&source.Source{Body: []byte("# a\r\nb #c\n#")}))
+	for l.next().Kind != EOF {
+	}
+	want := []Comment{{Start: 0, End: 3, Text: " a"}, {Start: 7, End: 9, Text: "c"}, {Start: 10, End: 11, Text: ""}}
+	if !reflect.DeepEqual(l.comments, want) {
+		t.Errorf("got comments %v, want %v", l.comments, want)
+	}
+}
+
+func TestBlockStringValue(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{raw: "", want: ""},
+		{raw: "a", want: "a"},
+		{raw: "  a\n  b", want: "  a\nb"},
+		{raw: "\n  a\n    b\n  ", want: "a\n  b"},
+		{raw: "\r\n  a\r\n\r\n  b\r\n", want: "a\n\nb"},
+		{raw: "\r  a\r  b", want: "a\nb"},
+		{raw: "\n \t\n  a\n \n", want: "a"},
+		{raw: "x\n\ta\n\t\tb", want: "x\na\n\tb"},
+	}
+	for _, tt := range tests {
+		if got := BlockStringValue(tt.raw); got != tt.want {
+			t.Errorf("BlockStringValue(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
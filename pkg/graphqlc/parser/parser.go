@@ -0,0 +1,1590 @@
+// Package parser parses GraphQL documents as defined by the October 2021
+// specification.
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+// File is a parsed document.
+type File struct {
+	Document *ast.Document
+
+	// Descriptors of the type system definitions and extensions of the
+	// document, in document order
+	Descriptor *graphqlc.FileDescriptorGraphql
+
+	// Every token and comment of the source, in order
+	Tokens   []Token
+	Comments []Comment
+
+	// Directives of variable definitions, graphql-go's VariableDefinition
+	// has no field for them
+	VariableDirectives map[*ast.VariableDefinition][]*ast.Directive
+}
+
+// Parse parses a document. A syntax error abandons the definition it is
+// found in, parsing resumes at the start of the next definition so every
+// syntax error of the source is reported. The document holds the
+// definitions parsed without error. Errors are ordered by position.
+//
+// A byte order mark at the start of the source is removed from its body,
+// positions count from the character following it.
+func Parse(src *source.Source) (*File, []*Error) {
+	src.Body = bytes.TrimPrefix(src.Body, byteOrderMark)
+	p := &parser{
+		source: src,
+		lex:    newLexer(src),
+		file: &File{
+			Descriptor:         &graphqlc.FileDescriptorGraphql{},
+			VariableDirectives: make(map[*ast.VariableDefinition][]*ast.Directive),
+		},
+	}
+	p.token = p.lex.next()
+
+	var errs []*Error
+	var definitions []ast.Node
+	if p.peek(EOF) {
+		errs = append(errs, p.errorf(p.token, "expected a definition, found %s", p.token))
+	}
+	for !p.peek(EOF) {
+		start := p.token.Start
+		def, err := p.parseDefinition()
+		if err != nil {
+			errs = append(errs, err.(*Error))
+			p.synchronize(start)
+			continue
+		}
+		definitions = append(definitions, def)
+		p.file.addDescriptor(def)
+	}
+
+	errs = append(errs, p.lex.errs...)
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Loc.Start < errs[j].Loc.Start
+	})
+
+	p.file.Document = ast.NewDocument(&ast.Document{
+		Definitions: definitions,
+		Loc:         ast.NewLocation(&ast.Location{Start: 0, End: len(src.Body), Source: src}),
+	})
+	p.file.Tokens = p.lex.tokens
+	p.file.Comments = p.lex.comments
+	return p.file, errs
+}
+
+// parser is a recursive descent parser with a single token of lookahead.
+type parser struct {
+	source  *source.Source
+	lex     *lexer
+	file    *File
+	token   Token  // current token
+	ahead   *Token // token after the current one, once peeked at
+	prevEnd int    // end of the last token consumed
+	depth   int    // nesting of brackets, braces and parentheses
+}
+
+var typeSystemKeywords = map[string]bool{
+	"schema":    true,
+	"scalar":    true,
+	"type":      true,
+	"interface": true,
+	"union":     true,
+	"enum":      true,
+	"input":     true,
+	"directive": true,
+}
+
+var definitionKeywords = map[string]bool{
+	"schema":       true,
+	"scalar":       true,
+	"type":         true,
+	"interface":    true,
+	"union":        true,
+	"enum":         true,
+	"input":        true,
+	"directive":    true,
+	"extend":       true,
+	"query":        true,
+	"mutation":     true,
+	"subscription": true,
+	"fragment":     true,
+}
+
+// synchronize skips the rest of a definition with a syntax error, start is
+// where the definition starts. Parsing resumes at the next token which may
+// start a definition at the top level, or at the start of a line with a
+// definition keyword or a description, as an unbalanced bracket is the
+// most likely syntax error.
+func (p *parser) synchronize(start int) {
+	if p.token.Start == start && !p.peek(EOF) {
+		p.advance()
+	}
+	for !p.peek(EOF) && !p.startsDefinition() {
+		p.advance()
+	}
+	p.depth = 0
+}
+
+func (p *parser) startsDefinition() bool {
+	lineStart := p.token.Start == 0 || p.source.Body[p.token.Start-1] == '\n' || p.source.Body[p.token.Start-1] == '\r'
+	if p.depth > 0 && !lineStart {
+		return false
+	}
+	switch p.token.Kind {
+	case BraceL:
+		return p.depth == 0
+	case String, BlockString:
+		next := p.lookahead()
+		return next.Kind == Name && typeSystemKeywords[next.Value]
+	case Name:
+		return definitionKeywords[p.token.Value]
+	}
+	return false
+}
+
+/**
+ * Definition :
+ *   - ExecutableDefinition
+ *   - TypeSystemDefinition
+ *   - TypeSystemExtension
+ *
+ * ExecutableDefinition :
+ *   - OperationDefinition
+ *   - FragmentDefinition
+ */
+func (p *parser) parseDefinition() (ast.Node, error) {
+	switch p.token.Kind {
+	case BraceL:
+		return p.parseOperationDefinition()
+	case String, BlockString:
+		return p.parseTypeSystemDefinition()
+	case Name:
+		switch p.token.Value {
+		case "query", "mutation", "subscription":
+			return p.parseOperationDefinition()
+		case "fragment":
+			return p.parseFragmentDefinition()
+		case "extend":
+			return p.parseTypeExtension()
+		}
+		if typeSystemKeywords[p.token.Value] {
+			return p.parseTypeSystemDefinition()
+		}
+	}
+	return nil, p.unexpected()
+}
+
+/**
+ * TypeSystemDefinition :
+ *   - SchemaDefinition
+ *   - TypeDefinition
+ *   - DirectiveDefinition
+ *
+ * TypeDefinition :
+ *   - ScalarTypeDefinition
+ *   - ObjectTypeDefinition
+ *   - InterfaceTypeDefinition
+ *   - UnionTypeDefinition
+ *   - EnumTypeDefinition
+ *   - InputObjectTypeDefinition
+ */
+func (p *parser) parseTypeSystemDefinition() (ast.Node, error) {
+	keyword := p.token
+	if p.peek(String) || p.peek(BlockString) {
+		keyword = p.lookahead()
+	}
+	if keyword.Kind == Name {
+		switch keyword.Value {
+		case "schema":
+			return p.parseSchemaDefinition()
+		case "scalar":
+			return p.parseScalarTypeDefinition()
+		case "type":
+			return p.parseObjectTypeDefinition()
+		case "interface":
+			return p.parseInterfaceTypeDefinition()
+		case "union":
+			return p.parseUnionTypeDefinition()
+		case "enum":
+			return p.parseEnumTypeDefinition()
+		case "input":
+			return p.parseInputObjectTypeDefinition()
+		case "directive":
+			return p.parseDirectiveDefinition()
+		}
+	}
+	return nil, p.unexpectedAt(keyword)
+}
+
+/**
+ * OperationDefinition :
+ *   - SelectionSet
+ *   - OperationType Name? VariableDefinitions? Directives? SelectionSet
+ *
+ * OperationType : one of query mutation subscription
+ */
+func (p *parser) parseOperationDefinition() (*ast.OperationDefinition, error) {
+	start := p.token.Start
+	if p.peek(BraceL) {
+		selectionSet, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		return ast.NewOperationDefinition(&ast.OperationDefinition{
+			Operation:    ast.OperationTypeQuery,
+			SelectionSet: selectionSet,
+			Loc:          p.loc(start),
+		}), nil
+	}
+
+	operation, err := p.expect(Name)
+	if err != nil {
+		return nil, err
+	}
+	switch operation.Value {
+	case ast.OperationTypeQuery, ast.OperationTypeMutation, ast.OperationTypeSubscription:
+	default:
+		return nil, p.unexpectedAt(operation)
+	}
+	var name *ast.Name
+	if p.peek(Name) {
+		if name, err = p.parseName(); err != nil {
+			return nil, err
+		}
+	}
+	variableDefinitions, err := p.parseVariableDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(false)
+	if err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewOperationDefinition(&ast.OperationDefinition{
+		Operation:           operation.Value,
+		Name:                name,
+		VariableDefinitions: variableDefinitions,
+		Directives:          directives,
+		SelectionSet:        selectionSet,
+		Loc:                 p.loc(start),
+	}), nil
+}
+
+/**
+ * VariableDefinitions : ( VariableDefinition+ )
+ *
+ * VariableDefinition : Variable : Type DefaultValue? Directives[Const]?
+ */
+func (p *parser) parseVariableDefinitions() ([]*ast.VariableDefinition, error) {
+	var defs []*ast.VariableDefinition
+	if !p.peek(ParenL) {
+		return defs, nil
+	}
+	err := p.many(ParenL, ParenR, func() error {
+		start := p.token.Start
+		variable, err := p.parseVariable()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect(Colon); err != nil {
+			return err
+		}
+		ttype, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		var defaultValue ast.Value
+		if p.skip(Equals) {
+			if defaultValue, err = p.parseValue(true); err != nil {
+				return err
+			}
+		}
+		directives, err := p.parseDirectives(true)
+		if err != nil {
+			return err
+		}
+		def := ast.NewVariableDefinition(&ast.VariableDefinition{
+			Variable:     variable,
+			Type:         ttype,
+			DefaultValue: defaultValue,
+			Loc:          p.loc(start),
+		})
+		if len(directives) > 0 {
+			p.file.VariableDirectives[def] = directives
+		}
+		defs = append(defs, def)
+		return nil
+	})
+	return defs, err
+}
+
+/**
+ * Variable : $ Name
+ */
+func (p *parser) parseVariable() (*ast.Variable, error) {
+	start := p.token.Start
+	if _, err := p.expect(Dollar); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewVariable(&ast.Variable{Name: name, Loc: p.loc(start)}), nil
+}
+
+/**
+ * SelectionSet : { Selection+ }
+ *
+ * Selection :
+ *   - Field
+ *   - FragmentSpread
+ *   - InlineFragment
+ */
+func (p *parser) parseSelectionSet() (*ast.SelectionSet, error) {
+	start := p.token.Start
+	var selections []ast.Selection
+	err := p.many(BraceL, BraceR, func() error {
+		var selection ast.Selection
+		var err error
+		if p.peek(Spread) {
+			selection, err = p.parseFragment()
+		} else {
+			selection, err = p.parseField()
+		}
+		selections = append(selections, selection)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewSelectionSet(&ast.SelectionSet{Selections: selections, Loc: p.loc(start)}), nil
+}
+
+/**
+ * Field : Alias? Name Arguments? Directives? SelectionSet?
+ *
+ * Alias : Name :
+ */
+func (p *parser) parseField() (*ast.Field, error) {
+	start := p.token.Start
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	var alias *ast.Name
+	if p.skip(Colon) {
+		alias = name
+		if name, err = p.parseName(); err != nil {
+			return nil, err
+		}
+	}
+	args, err := p.parseArguments(false)
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(false)
+	if err != nil {
+		return nil, err
+	}
+	var selectionSet *ast.SelectionSet
+	if p.peek(BraceL) {
+		if selectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return ast.NewField(&ast.Field{
+		Alias:        alias,
+		Name:         name,
+		Arguments:    args,
+		Directives:   directives,
+		SelectionSet: selectionSet,
+		Loc:          p.loc(start),
+	}), nil
+}
+
+/**
+ * FragmentSpread : ... FragmentName Directives?
+ *
+ * InlineFragment : ... TypeCondition? Directives? SelectionSet
+ */
+func (p *parser) parseFragment() (ast.Selection, error) {
+	start := p.token.Start
+	if _, err := p.expect(Spread); err != nil {
+		return nil, err
+	}
+	if p.peek(Name) && p.token.Value != "on" {
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		directives, err := p.parseDirectives(false)
+		if err != nil {
+			return nil, err
+		}
+		return ast.NewFragmentSpread(&ast.FragmentSpread{
+			Name:       name,
+			Directives: directives,
+			Loc:        p.loc(start),
+		}), nil
+	}
+
+	var typeCondition *ast.Named
+	if p.peek(Name) {
+		p.advance()
+		var err error
+		if typeCondition, err = p.parseNamed(); err != nil {
+			return nil, err
+		}
+	}
+	directives, err := p.parseDirectives(false)
+	if err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewInlineFragment(&ast.InlineFragment{
+		TypeCondition: typeCondition,
+		Directives:    directives,
+		SelectionSet:  selectionSet,
+		Loc:           p.loc(start),
+	}), nil
+}
+
+/**
+ * FragmentDefinition :
+ *   - fragment FragmentName TypeCondition Directives? SelectionSet
+ *
+ * FragmentName : Name but not `on`
+ *
+ * TypeCondition : on NamedType
+ */
+func (p *parser) parseFragmentDefinition() (*ast.FragmentDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("fragment"); err != nil {
+		return nil, err
+	}
+	if p.peek(Name) && p.token.Value == "on" {
+		return nil, p.unexpected()
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expectKeyword("on"); err != nil {
+		return nil, err
+	}
+	typeCondition, err := p.parseNamed()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(false)
+	if err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewFragmentDefinition(&ast.FragmentDefinition{
+		Name:          name,
+		TypeCondition: typeCondition,
+		Directives:    directives,
+		SelectionSet:  selectionSet,
+		Loc:           p.loc(start),
+	}), nil
+}
+
+/**
+ * SchemaDefinition : Description? schema Directives[Const]? { OperationTypeDefinition+ }
+ */
+func (p *parser) parseSchemaDefinition() (*SchemaDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("schema"); err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	operationTypes, err := p.parseOperationTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaDefinition{
+		SchemaDefinition: ast.NewSchemaDefinition(&ast.SchemaDefinition{
+			Directives:     directives,
+			OperationTypes: operationTypes,
+			Loc:            p.loc(start),
+		}),
+		Description: description,
+	}, nil
+}
+
+/**
+ * OperationTypeDefinition : OperationType : NamedType
+ */
+func (p *parser) parseOperationTypeDefinitions() ([]*ast.OperationTypeDefinition, error) {
+	var operationTypes []*ast.OperationTypeDefinition
+	err := p.many(BraceL, BraceR, func() error {
+		start := p.token.Start
+		operation, err := p.expect(Name)
+		if err != nil {
+			return err
+		}
+		switch operation.Value {
+		case ast.OperationTypeQuery, ast.OperationTypeMutation, ast.OperationTypeSubscription:
+		default:
+			return p.unexpectedAt(operation)
+		}
+		if _, err := p.expect(Colon); err != nil {
+			return err
+		}
+		named, err := p.parseNamed()
+		if err != nil {
+			return err
+		}
+		operationTypes = append(operationTypes, ast.NewOperationTypeDefinition(&ast.OperationTypeDefinition{
+			Operation: operation.Value,
+			Type:      named,
+			Loc:       p.loc(start),
+		}))
+		return nil
+	})
+	return operationTypes, err
+}
+
+/**
+ * ScalarTypeDefinition : Description? scalar Name Directives[Const]?
+ */
+func (p *parser) parseScalarTypeDefinition() (*ast.ScalarDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("scalar"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewScalarDefinition(&ast.ScalarDefinition{
+		Name:        name,
+		Description: description,
+		Directives:  directives,
+		Loc:         p.loc(start),
+	}), nil
+}
+
+/**
+ * ObjectTypeDefinition :
+ *   - Description? type Name ImplementsInterfaces? Directives[Const]? FieldsDefinition?
+ */
+func (p *parser) parseObjectTypeDefinition() (*ast.ObjectDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("type"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := p.parseImplementsInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := p.parseFieldsDefinition()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewObjectDefinition(&ast.ObjectDefinition{
+		Name:        name,
+		Description: description,
+		Interfaces:  interfaces,
+		Directives:  directives,
+		Fields:      fields,
+		Loc:         p.loc(start),
+	}), nil
+}
+
+/**
+ * InterfaceTypeDefinition :
+ *   - Description? interface Name ImplementsInterfaces? Directives[Const]? FieldsDefinition?
+ */
+func (p *parser) parseInterfaceTypeDefinition() (*InterfaceDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("interface"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := p.parseImplementsInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := p.parseFieldsDefinition()
+	if err != nil {
+		return nil, err
+	}
+	return &InterfaceDefinition{
+		InterfaceDefinition: ast.NewInterfaceDefinition(&ast.InterfaceDefinition{
+			Name:        name,
+			Description: description,
+			Directives:  directives,
+			Fields:      fields,
+			Loc:         p.loc(start),
+		}),
+		Interfaces: interfaces,
+	}, nil
+}
+
+/**
+ * UnionTypeDefinition : Description? union Name Directives[Const]? UnionMemberTypes?
+ */
+func (p *parser) parseUnionTypeDefinition() (*ast.UnionDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("union"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	types, err := p.parseUnionMemberTypes()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewUnionDefinition(&ast.UnionDefinition{
+		Name:        name,
+		Description: description,
+		Directives:  directives,
+		Types:       types,
+		Loc:         p.loc(start),
+	}), nil
+}
+
+/**
+ * EnumTypeDefinition : Description? enum Name Directives[Const]? EnumValuesDefinition?
+ */
+func (p *parser) parseEnumTypeDefinition() (*ast.EnumDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("enum"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	values, err := p.parseEnumValuesDefinition()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewEnumDefinition(&ast.EnumDefinition{
+		Name:        name,
+		Description: description,
+		Directives:  directives,
+		Values:      values,
+		Loc:         p.loc(start),
+	}), nil
+}
+
+/**
+ * InputObjectTypeDefinition :
+ *   - Description? input Name Directives[Const]? InputFieldsDefinition?
+ */
+func (p *parser) parseInputObjectTypeDefinition() (*ast.InputObjectDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("input"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*ast.InputValueDefinition
+	if p.peek(BraceL) {
+		if fields, err = p.parseInputValueDefs(BraceL, BraceR); err != nil {
+			return nil, err
+		}
+	}
+	return ast.NewInputObjectDefinition(&ast.InputObjectDefinition{
+		Name:        name,
+		Description: description,
+		Directives:  directives,
+		Fields:      fields,
+		Loc:         p.loc(start),
+	}), nil
+}
+
+// isDirectiveLocation reports whether name is an executable or a type system
+// directive location.
+func isDirectiveLocation(name string) bool {
+	_, executable := graphqlc.ExecutableDirectiveLocation_value[name]
+	_, typeSystem := graphqlc.TypeSystemDirectiveLocation_value[name]
+	return executable || typeSystem
+}
+
+/**
+ * DirectiveDefinition :
+ *   - Description? directive @ Name ArgumentsDefinition? repeatable? on DirectiveLocations
+ *
+ * DirectiveLocations :
+ *   - `|`? DirectiveLocation
+ *   - DirectiveLocations | DirectiveLocation
+ */
+func (p *parser) parseDirectiveDefinition() (*DirectiveDefinition, error) {
+	start := p.token.Start
+	description := p.parseDescription()
+	if _, err := p.expectKeyword("directive"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(At); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	var args []*ast.InputValueDefinition
+	if p.peek(ParenL) {
+		if args, err = p.parseInputValueDefs(ParenL, ParenR); err != nil {
+			return nil, err
+		}
+	}
+	repeatable := false
+	if p.peek(Name) && p.token.Value == "repeatable" {
+		repeatable = true
+		p.advance()
+	}
+	if _, err := p.expectKeyword("on"); err != nil {
+		return nil, err
+	}
+	p.skip(Pipe)
+	var locations []*ast.Name
+	for {
+		token := p.token
+		location, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		if !isDirectiveLocation(location.Value) {
+			return nil, p.errorf(token, "unknown directive location %q", location.Value)
+		}
+		locations = append(locations, location)
+		if !p.skip(Pipe) {
+			break
+		}
+	}
+	return &DirectiveDefinition{
+		DirectiveDefinition: ast.NewDirectiveDefinition(&ast.DirectiveDefinition{
+			Name:        name,
+			Description: description,
+			Arguments:   args,
+			Locations:   locations,
+			Loc:         p.loc(start),
+		}),
+		Repeatable: repeatable,
+	}, nil
+}
+
+/**
+ * TypeSystemExtension :
+ *   - SchemaExtension
+ *   - TypeExtension
+ */
+func (p *parser) parseTypeExtension() (*TypeExtension, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("extend"); err != nil {
+		return nil, err
+	}
+
+	var def ast.Node
+	var err error
+	switch p.token.Value {
+	case "schema":
+		def, err = p.parseSchemaExtension()
+	case "scalar":
+		def, err = p.parseScalarTypeExtension()
+	case "type":
+		def, err = p.parseObjectTypeExtension()
+	case "interface":
+		def, err = p.parseInterfaceTypeExtension()
+	case "union":
+		def, err = p.parseUnionTypeExtension()
+	case "enum":
+		def, err = p.parseEnumTypeExtension()
+	case "input":
+		def, err = p.parseInputObjectTypeExtension()
+	default:
+		return nil, p.unexpected()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &TypeExtension{Loc: p.loc(start), Definition: def}, nil
+}
+
+/**
+ * SchemaExtension :
+ *   - extend schema Directives[Const]? { OperationTypeDefinition+ }
+ *   - extend schema Directives[Const]
+ */
+func (p *parser) parseSchemaExtension() (*ast.SchemaDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("schema"); err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	var operationTypes []*ast.OperationTypeDefinition
+	if p.peek(BraceL) {
+		if operationTypes, err = p.parseOperationTypeDefinitions(); err != nil {
+			return nil, err
+		}
+	} else if len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewSchemaDefinition(&ast.SchemaDefinition{
+		Directives:     directives,
+		OperationTypes: operationTypes,
+		Loc:            p.loc(start),
+	}), nil
+}
+
+/**
+ * ScalarTypeExtension :
+ *   - extend scalar Name Directives[Const]
+ */
+func (p *parser) parseScalarTypeExtension() (*ast.ScalarDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("scalar"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	if len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewScalarDefinition(&ast.ScalarDefinition{
+		Name:       name,
+		Directives: directives,
+		Loc:        p.loc(start),
+	}), nil
+}
+
+/**
+ * ObjectTypeExtension :
+ *   - extend type Name ImplementsInterfaces? Directives[Const]? FieldsDefinition
+ *   - extend type Name ImplementsInterfaces? Directives[Const]
+ *   - extend type Name ImplementsInterfaces
+ */
+func (p *parser) parseObjectTypeExtension() (*ast.ObjectDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("type"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := p.parseImplementsInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := p.parseFieldsDefinition()
+	if err != nil {
+		return nil, err
+	}
+	if len(interfaces) == 0 && len(directives) == 0 && len(fields) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewObjectDefinition(&ast.ObjectDefinition{
+		Name:       name,
+		Interfaces: interfaces,
+		Directives: directives,
+		Fields:     fields,
+		Loc:        p.loc(start),
+	}), nil
+}
+
+/**
+ * InterfaceTypeExtension :
+ *   - extend interface Name ImplementsInterfaces? Directives[Const]? FieldsDefinition
+ *   - extend interface Name ImplementsInterfaces? Directives[Const]
+ *   - extend interface Name ImplementsInterfaces
+ */
+func (p *parser) parseInterfaceTypeExtension() (*InterfaceDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("interface"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	interfaces, err := p.parseImplementsInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := p.parseFieldsDefinition()
+	if err != nil {
+		return nil, err
+	}
+	if len(interfaces) == 0 && len(directives) == 0 && len(fields) == 0 {
+		return nil, p.unexpected()
+	}
+	return &InterfaceDefinition{
+		InterfaceDefinition: ast.NewInterfaceDefinition(&ast.InterfaceDefinition{
+			Name:       name,
+			Directives: directives,
+			Fields:     fields,
+			Loc:        p.loc(start),
+		}),
+		Interfaces: interfaces,
+	}, nil
+}
+
+/**
+ * UnionTypeExtension :
+ *   - extend union Name Directives[Const]? UnionMemberTypes
+ *   - extend union Name Directives[Const]
+ */
+func (p *parser) parseUnionTypeExtension() (*ast.UnionDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("union"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	types, err := p.parseUnionMemberTypes()
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 && len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewUnionDefinition(&ast.UnionDefinition{
+		Name:       name,
+		Directives: directives,
+		Types:      types,
+		Loc:        p.loc(start),
+	}), nil
+}
+
+/**
+ * EnumTypeExtension :
+ *   - extend enum Name Directives[Const]? EnumValuesDefinition
+ *   - extend enum Name Directives[Const]
+ */
+func (p *parser) parseEnumTypeExtension() (*ast.EnumDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("enum"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	values, err := p.parseEnumValuesDefinition()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 && len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewEnumDefinition(&ast.EnumDefinition{
+		Name:       name,
+		Directives: directives,
+		Values:     values,
+		Loc:        p.loc(start),
+	}), nil
+}
+
+/**
+ * InputObjectTypeExtension :
+ *   - extend input Name Directives[Const]? InputFieldsDefinition
+ *   - extend input Name Directives[Const]
+ */
+func (p *parser) parseInputObjectTypeExtension() (*ast.InputObjectDefinition, error) {
+	start := p.token.Start
+	if _, err := p.expectKeyword("input"); err != nil {
+		return nil, err
+	}
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	directives, err := p.parseDirectives(true)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*ast.InputValueDefinition
+	if p.peek(BraceL) {
+		if fields, err = p.parseInputValueDefs(BraceL, BraceR); err != nil {
+			return nil, err
+		}
+	} else if len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+	return ast.NewInputObjectDefinition(&ast.InputObjectDefinition{
+		Name:       name,
+		Directives: directives,
+		Fields:     fields,
+		Loc:        p.loc(start),
+	}), nil
+}
+
+/**
+ * ImplementsInterfaces :
+ *   - implements `&`? NamedType
+ *   - ImplementsInterfaces & NamedType
+ */
+func (p *parser) parseImplementsInterfaces() ([]*ast.Named, error) {
+	var types []*ast.Named
+	if !p.peek(Name) || p.token.Value != "implements" {
+		return types, nil
+	}
+	p.advance()
+	p.skip(Amp)
+	for {
+		named, err := p.parseNamed()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, named)
+		if !p.skip(Amp) {
+			break
+		}
+	}
+	return types, nil
+}
+
+/**
+ * UnionMemberTypes :
+ *   - = `|`? NamedType
+ *   - UnionMemberTypes | NamedType
+ */
+func (p *parser) parseUnionMemberTypes() ([]*ast.Named, error) {
+	var types []*ast.Named
+	if !p.skip(Equals) {
+		return types, nil
+	}
+	p.skip(Pipe)
+	for {
+		named, err := p.parseNamed()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, named)
+		if !p.skip(Pipe) {
+			break
+		}
+	}
+	return types, nil
+}
+
+/**
+ * FieldsDefinition : { FieldDefinition+ }
+ *
+ * FieldDefinition : Description? Name ArgumentsDefinition? : Type Directives[Const]?
+ */
+func (p *parser) parseFieldsDefinition() ([]*ast.FieldDefinition, error) {
+	var fields []*ast.FieldDefinition
+	if !p.peek(BraceL) {
+		return fields, nil
+	}
+	err := p.many(BraceL, BraceR, func() error {
+		start := p.token.Start
+		description := p.parseDescription()
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		var args []*ast.InputValueDefinition
+		if p.peek(ParenL) {
+			if args, err = p.parseInputValueDefs(ParenL, ParenR); err != nil {
+				return err
+			}
+		}
+		if _, err := p.expect(Colon); err != nil {
+			return err
+		}
+		ttype, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		directives, err := p.parseDirectives(true)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, ast.NewFieldDefinition(&ast.FieldDefinition{
+			Name:        name,
+			Description: description,
+			Arguments:   args,
+			Type:        ttype,
+			Directives:  directives,
+			Loc:         p.loc(start),
+		}))
+		return nil
+	})
+	return fields, err
+}
+
+/**
+ * EnumValuesDefinition : { EnumValueDefinition+ }
+ *
+ * EnumValueDefinition : Description? EnumValue Directives[Const]?
+ *
+ * EnumValue : Name but not `true`, `false` or `null`
+ */
+func (p *parser) parseEnumValuesDefinition() ([]*ast.EnumValueDefinition, error) {
+	var values []*ast.EnumValueDefinition
+	if !p.peek(BraceL) {
+		return values, nil
+	}
+	err := p.many(BraceL, BraceR, func() error {
+		start := p.token.Start
+		description := p.parseDescription()
+		if p.peek(Name) && (p.token.Value == "true" || p.token.Value == "false" || p.token.Value == "null") {
+			return p.unexpected()
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		directives, err := p.parseDirectives(true)
+		if err != nil {
+			return err
+		}
+		values = append(values, ast.NewEnumValueDefinition(&ast.EnumValueDefinition{
+			Name:        name,
+			Description: description,
+			Directives:  directives,
+			Loc:         p.loc(start),
+		}))
+		return nil
+	})
+	return values, err
+}
+
+/**
+ * InputValueDefinition : Description? Name : Type DefaultValue? Directives[Const]?
+ */
+func (p *parser) parseInputValueDefs(openKind, closeKind TokenKind) ([]*ast.InputValueDefinition, error) {
+	var defs []*ast.InputValueDefinition
+	err := p.many(openKind, closeKind, func() error {
+		start := p.token.Start
+		description := p.parseDescription()
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect(Colon); err != nil {
+			return err
+		}
+		ttype, err := p.parseType()
+		if err != nil {
+			return err
+		}
+		var defaultValue ast.Value
+		if p.skip(Equals) {
+			if defaultValue, err = p.parseValue(true); err != nil {
+				return err
+			}
+		}
+		directives, err := p.parseDirectives(true)
+		if err != nil {
+			return err
+		}
+		defs = append(defs, ast.NewInputValueDefinition(&ast.InputValueDefinition{
+			Name:         name,
+			Description:  description,
+			Type:         ttype,
+			DefaultValue: defaultValue,
+			Directives:   directives,
+			Loc:          p.loc(start),
+		}))
+		return nil
+	})
+	return defs, err
+}
+
+/**
+ * Directives[Const] : Directive[?Const]+
+ *
+ * Directive[Const] : @ Name Arguments[?Const]?
+ */
+func (p *parser) parseDirectives(isConst bool) ([]*ast.Directive, error) {
+	var directives []*ast.Directive
+	for p.peek(At) {
+		start := p.token.Start
+		p.advance()
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		args, err := p.parseArguments(isConst)
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, ast.NewDirective(&ast.Directive{
+			Name:      name,
+			Arguments: args,
+			Loc:       p.loc(start),
+		}))
+	}
+	return directives, nil
+}
+
+/**
+ * Arguments[Const] : ( Argument[?Const]+ )
+ *
+ * Argument[Const] : Name : Value[?Const]
+ */
+func (p *parser) parseArguments(isConst bool) ([]*ast.Argument, error) {
+	var args []*ast.Argument
+	if !p.peek(ParenL) {
+		return args, nil
+	}
+	err := p.many(ParenL, ParenR, func() error {
+		start := p.token.Start
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect(Colon); err != nil {
+			return err
+		}
+		value, err := p.parseValue(isConst)
+		if err != nil {
+			return err
+		}
+		args = append(args, ast.NewArgument(&ast.Argument{
+			Name:  name,
+			Value: value,
+			Loc:   p.loc(start),
+		}))
+		return nil
+	})
+	return args, err
+}
+
+/**
+ * Type :
+ *   - NamedType
+ *   - ListType
+ *   - NonNullType
+ */
+func (p *parser) parseType() (ast.Type, error) {
+	start := p.token.Start
+	var ttype ast.Type
+	if p.skip(BracketL) {
+		inner, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(BracketR); err != nil {
+			return nil, err
+		}
+		ttype = ast.NewList(&ast.List{Type: inner, Loc: p.loc(start)})
+	} else {
+		named, err := p.parseNamed()
+		if err != nil {
+			return nil, err
+		}
+		ttype = named
+	}
+	if p.skip(Bang) {
+		return ast.NewNonNull(&ast.NonNull{Type: ttype, Loc: p.loc(start)}), nil
+	}
+	return ttype, nil
+}
+
+func (p *parser) parseNamed() (*ast.Named, error) {
+	start := p.token.Start
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewNamed(&ast.Named{Name: name, Loc: p.loc(start)}), nil
+}
+
+func (p *parser) parseName() (*ast.Name, error) {
+	token, err := p.expect(Name)
+	if err != nil {
+		return nil, err
+	}
+	return ast.NewName(&ast.Name{Value: token.Value, Loc: p.loc(token.Start)}), nil
+}
+
+/**
+ * Description : StringValue
+ */
+func (p *parser) parseDescription() *ast.StringValue {
+	if !p.peek(String) && !p.peek(BlockString) {
+		return nil
+	}
+	token := p.token
+	p.advance()
+	return ast.NewStringValue(&ast.StringValue{Value: token.Value, Loc: p.loc(token.Start)})
+}
+
+/**
+ * Value[Const] :
+ *   - [~Const] Variable
+ *   - IntValue
+ *   - FloatValue
+ *   - StringValue
+ *   - BooleanValue
+ *   - NullValue
+ *   - EnumValue
+ *   - ListValue[?Const]
+ *   - ObjectValue[?Const]
+ */
+func (p *parser) parseValue(isConst bool) (ast.Value, error) {
+	token := p.token
+	switch token.Kind {
+	case BracketL:
+		var values []ast.Value
+		if err := p.any(BracketL, BracketR, func() error {
+			value, err := p.parseValue(isConst)
+			values = append(values, value)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		return ast.NewListValue(&ast.ListValue{Values: values, Loc: p.loc(token.Start)}), nil
+	case BraceL:
+		var fields []*ast.ObjectField
+		if err := p.any(BraceL, BraceR, func() error {
+			start := p.token.Start
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			if _, err := p.expect(Colon); err != nil {
+				return err
+			}
+			value, err := p.parseValue(isConst)
+			if err != nil {
+				return err
+			}
+			fields = append(fields, ast.NewObjectField(&ast.ObjectField{Name: name, Value: value, Loc: p.loc(start)}))
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{Fields: fields, Loc: p.loc(token.Start)}), nil
+	case Int:
+		p.advance()
+		return ast.NewIntValue(&ast.IntValue{Value: token.Value, Loc: p.loc(token.Start)}), nil
+	case Float:
+		p.advance()
+		return ast.NewFloatValue(&ast.FloatValue{Value: token.Value, Loc: p.loc(token.Start)}), nil
+	case String, BlockString:
+		p.advance()
+		return ast.NewStringValue(&ast.StringValue{Value: token.Value, Loc: p.loc(token.Start)}), nil
+	case Name:
+		p.advance()
+		switch token.Value {
+		case "true", "false":
+			return ast.NewBooleanValue(&ast.BooleanValue{Value: token.Value == "true", Loc: p.loc(token.Start)}), nil
+		case "null":
+			return &NullValue{Loc: p.loc(token.Start)}, nil
+		}
+		return ast.NewEnumValue(&ast.EnumValue{Value: token.Value, Loc: p.loc(token.Start)}), nil
+	case Dollar:
+		if !isConst {
+			return p.parseVariable()
+		}
+		return nil, p.errorf(token, "unexpected variable in a constant value")
+	}
+	return nil, p.unexpected()
+}
+
+// Parser utilities, these mirror the ones in graphql-go's parser.
+
+// many parses one or more nodes between openKind and closeKind.
+func (p *parser) many(openKind, closeKind TokenKind, parseFn func() error) error {
+	if _, err := p.expect(openKind); err != nil {
+		return err
+	}
+	if err := parseFn(); err != nil {
+		return err
+	}
+	for !p.skip(closeKind) {
+		if err := parseFn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// any parses zero or more nodes between openKind and closeKind.
+func (p *parser) any(openKind, closeKind TokenKind, parseFn func() error) error {
+	if _, err := p.expect(openKind); err != nil {
+		return err
+	}
+	for !p.skip(closeKind) {
+		if err := parseFn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advance moves to the next token, keeping track of nesting.
+func (p *parser) advance() {
+	switch p.token.Kind {
+	case BraceL, ParenL, BracketL:
+		p.depth++
+	case BraceR, ParenR, BracketR:
+		if p.depth > 0 {
+			p.depth--
+		}
+	}
+	p.prevEnd = p.token.End
+	if p.ahead != nil {
+		p.token = *p.ahead
+		p.ahead = nil
+		return
+	}
+	p.token = p.lex.next()
+}
+
+// lookahead returns the token after the current one.
+func (p *parser) lookahead() Token {
+	if p.ahead == nil {
+		token := p.lex.next()
+		p.ahead = &token
+	}
+	return *p.ahead
+}
+
+func (p *parser) peek(kind TokenKind) bool {
+	return p.token.Kind == kind
+}
+
+func (p *parser) skip(kind TokenKind) bool {
+	if p.token.Kind == kind {
+		p.advance()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind TokenKind) (Token, error) {
+	token := p.token
+	if token.Kind == kind {
+		p.advance()
+		return token, nil
+	}
+	return token, p.errorf(token, "expected %s, found %s", expected(kind), token)
+}
+
+func (p *parser) expectKeyword(value string) (Token, error) {
+	token := p.token
+	if token.Kind == Name && token.Value == value {
+		p.advance()
+		return token, nil
+	}
+	return token, p.errorf(token, "expected %q, found %s", value, token)
+}
+
+func (p *parser) unexpected() *Error {
+	return p.unexpectedAt(p.token)
+}
+
+func (p *parser) unexpectedAt(token Token) *Error {
+	return p.errorf(token, "unexpected %s", token)
+}
+
+func (p *parser) errorf(token Token, format string, args ...interface{}) *Error {
+	return &Error{
+		Loc:     &ast.Location{Start: token.Start, End: token.End, Source: p.source},
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *parser) loc(start int) *ast.Location {
+	return ast.NewLocation(&ast.Location{Start: start, End: p.prevEnd, Source: p.source})
+}
+
+// expected describes an expected token kind in error messages.
+func expected(kind TokenKind) string {
+	switch kind {
+	case Name, Int, Float, String, BlockString, EOF:
+		return kind.String()
+	}
+	return fmt.Sprintf("%q", kind.String())
+}
@@ -0,0 +1,384 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/samlitowitz/graphqlc/pkg/graphqlc"
+)
+
+func parse(body string) (*File, []string) {
+	file, errs := Parse(source.NewSource(&source.Source{Body: []byte(body), Name: "test.graphql"}))
+	return file, errorStrings(errs)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantDefs []string
+		wantErr  []string
+	}{
+		{
+			name: "type system",
+			body: `"""S""" schema @d { query: Q }
+				"Scalar" scalar S @specifiedBy(url: "https://example.com")
+				type A implements & B & C @d { a(x: Int = 1 @d): [Int!]! @e }
+				interface B implements C { a: Int }
+				union U = | A | B
+				enum E { X @deprecated Y }
+				input I { a: Int! = 1, b: [E] = [X] }
+				directive @d(a: Int) repeatable on SCHEMA | OBJECT | FIELD_DEFINITION | ARGUMENT_DEFINITION`,
+			wantDefs: []string{
+				"SchemaDefinition", "ScalarDefinition", "ObjectDefinition", "InterfaceDefinition",
+				"UnionDefinition", "EnumDefinition", "InputObjectDefinition", "DirectiveDefinition",
+			},
+		},
+		{
+			name: "type system extensions",
+			body: `extend schema @d extend scalar S @d extend type A implements B extend interface B { b: Int }
+				extend union U = C extend enum E { Z } extend input I @d`,
+			wantDefs: []string{
+				"TypeExtension", "TypeExtension", "TypeExtension", "TypeExtension",
+				"TypeExtension", "TypeExtension", "TypeExtension",
+			},
+		},
+		{
+			name: "executable",
+			body: `query Q($a: Int = 1 @d, $b: [I!]!) @d { a(x: $a, y: [1, {z: null, w: "s"}]) { ...F ... on A { b } ... @skip(if: true) { c } } }
+				{ d: a }
+				fragment F on A @d { c }`,
+			wantDefs: []string{"OperationDefinition", "OperationDefinition", "FragmentDefinition"},
+		},
+		{
+			name:    "empty",
+			body:    " # comment\n",
+			wantErr: []string{"test.graphql:2:1: expected a definition, found <EOF>"},
+		},
+		{
+			name:     "recovery",
+			body:     "type A { a: }\ntype B { b: Int }\nscalar",
+			wantDefs: []string{"ObjectDefinition"},
+			wantErr: []string{
+				`test.graphql:1:13: expected Name, found "}"`,
+				"test.graphql:3:7: expected Name, found <EOF>",
+			},
+		},
+		{
+			name:     "recovery with lexical errors",
+			body:     "type A { a: Int? }\nenum E { true }\ntype B { b: Int }",
+			wantDefs: []string{"ObjectDefinition", "ObjectDefinition"},
+			wantErr: []string{
+				`test.graphql:1:16: unexpected character '?'`,
+				`test.graphql:2:10: unexpected Name "true"`,
+			},
+		},
+		{
+			name:     "CRLF",
+			body:     "type A {\r\n  a: Int\r\n  b: [Int\r\n}\r\nscalar B",
+			wantDefs: []string{"ScalarDefinition"},
+			wantErr:  []string{`test.graphql:4:1: expected "]", found "}"`},
+		},
+		{
+			name:    "unterminated selection set",
+			body:    "{ a",
+			wantErr: []string{"test.graphql:1:4: expected Name, found <EOF>"},
+		},
+		{
+			name:     "fragment named on",
+			body:     "fragment on on A { a }",
+			wantDefs: []string{"OperationDefinition"},
+			wantErr:  []string{`test.graphql:1:10: unexpected Name "on"`},
+		},
+		{
+			name:    "type extension without body",
+			body:    "extend type A",
+			wantErr: []string{"test.graphql:1:14: unexpected <EOF>"},
+		},
+		{
+			name:    "unknown directive location",
+			body:    "directive @d on FIELD | NOPE",
+			wantErr: []string{`test.graphql:1:25: unknown directive location "NOPE"`},
+		},
+		{
+			name:    "byte order mark",
+			body:    "\uFEFFtype A { a: }",
+			wantErr: []string{`test.graphql:1:13: expected Name, found "}"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, gotErr := parse(tt.body)
+			var gotDefs []string
+			for _, def := range file.Document.Definitions {
+				gotDefs = append(gotDefs, def.GetKind())
+			}
+			if !reflect.DeepEqual(gotDefs, tt.wantDefs) {
+				t.Errorf("got definitions %v, want %v", gotDefs, tt.wantDefs)
+			}
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("got errors %q, want %q", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDescriptor(t *testing.T) {
+	named := func(name string) *graphqlc.TypeDescriptorProto {
+		return &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_NamedType{
+			NamedType: &graphqlc.NamedTypeDescriptorProto{Name: name},
+		}}
+	}
+	tests := []struct {
+		name string
+		body string
+		want *graphqlc.FileDescriptorGraphql
+	}{
+		{
+			name: "object",
+			body: `"An A" type A implements B @d(x: 1) { "The a" a(x: [Int!] = [1, 2]): A! }`,
+			want: &graphqlc.FileDescriptorGraphql{
+				Objects: []*graphqlc.ObjectTypeDefinitionDescriptorProto{{
+					Description: "An A",
+					Name:        "A",
+					Implements:  []*graphqlc.NamedTypeDescriptorProto{{Name: "B"}},
+					Directives: []*graphqlc.DirectiveDescriptorProto{{
+						Name: "d",
+						Arguments: []*graphqlc.ArgumentDescriptorProto{{
+							Name:  "x",
+							Value: &graphqlc.ValueDescriptorProto{Raw: "1", Value: &graphqlc.ValueDescriptorProto_IntValue{IntValue: 1}},
+						}},
+					}},
+					Fields: []*graphqlc.FieldDefinitionDescriptorProto{{
+						Description: "The a",
+						Name:        "a",
+						Arguments: []*graphqlc.InputValueDefinitionDescriptorProto{{
+							Name: "x",
+							Type: &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_ListType{
+								ListType: &graphqlc.ListTypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto{
+									Type: &graphqlc.TypeDescriptorProto_NonNullType{NonNullType: &graphqlc.NonNullTypeDescriptorProto{
+										Type: &graphqlc.NonNullTypeDescriptorProto_NamedType{NamedType: &graphqlc.NamedTypeDescriptorProto{Name: "Int"}},
+									}},
+								}},
+							}},
+							DefaultValue: &graphqlc.ValueDescriptorProto{
+								Raw: "[1, 2]",
+								Value: &graphqlc.ValueDescriptorProto_ListValue{ListValue: &graphqlc.ListValueDescriptorProto{
+									Values: []*graphqlc.ValueDescriptorProto{
+										{Raw: "1", Value: &graphqlc.ValueDescriptorProto_IntValue{IntValue: 1}},
+										{Raw: "2", Value: &graphqlc.ValueDescriptorProto_IntValue{IntValue: 2}},
+									},
+								}},
+							},
+						}},
+						Type: &graphqlc.TypeDescriptorProto{Type: &graphqlc.TypeDescriptorProto_NonNullType{
+							NonNullType: &graphqlc.NonNullTypeDescriptorProto{
+								Type: &graphqlc.NonNullTypeDescriptorProto_NamedType{NamedType: &graphqlc.NamedTypeDescriptorProto{Name: "A"}},
+							},
+						}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "values",
+			body: `input I { a: Int = 3000000000, b: Float = 1.5, c: Float = 0.1, d: String = null, e: E = X, f: I = {a: 1} }`,
+			want: &graphqlc.FileDescriptorGraphql{
+				InputObjects: []*graphqlc.InputObjectTypeDefinitionDescriptorProto{{
+					Name: "I",
+					Fields: []*graphqlc.InputValueDefinitionDescriptorProto{
+						{Name: "a", Type: named("Int"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "3000000000", Value: &graphqlc.ValueDescriptorProto_Int64Value{Int64Value: 3000000000},
+						}},
+						{Name: "b", Type: named("Float"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "1.5", Value: &graphqlc.ValueDescriptorProto_FloatValue{FloatValue: 1.5},
+						}},
+						{Name: "c", Type: named("Float"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "0.1", Value: &graphqlc.ValueDescriptorProto_DoubleValue{DoubleValue: 0.1},
+						}},
+						{Name: "d", Type: named("String"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "null", Value: &graphqlc.ValueDescriptorProto_NullValue{NullValue: &graphqlc.NullValueDescriptorProto{}},
+						}},
+						{Name: "e", Type: named("E"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "X", Value: &graphqlc.ValueDescriptorProto_EnumValue{EnumValue: &graphqlc.EnumValueDescriptorProto{Value: "X"}},
+						}},
+						{Name: "f", Type: named("I"), DefaultValue: &graphqlc.ValueDescriptorProto{
+							Raw: "{a: 1}",
+							Value: &graphqlc.ValueDescriptorProto_ObjectValue{ObjectValue: &graphqlc.ObjectValueDescriptorProto{
+								Fields: []*graphqlc.ObjectFieldDescriptorProto{{
+									Name:  "a",
+									Value: &graphqlc.ValueDescriptorProto{Raw: "1", Value: &graphqlc.ValueDescriptorProto_IntValue{IntValue: 1}},
+								}},
+							}},
+						}},
+					},
+				}},
+			},
+		},
+		{
+			name: "schema and directives",
+			body: `"S" schema { query: Q mutation: M } schema { query: R }
+				"The d" directive @d(x: Int) repeatable on QUERY | SCALAR
+				scalar URL @specifiedBy(url: "https://example.com")`,
+			want: &graphqlc.FileDescriptorGraphql{
+				Schema: &graphqlc.SchemaDescriptorProto{
+					Description: "S",
+					Query:       &graphqlc.NamedTypeDescriptorProto{Name: "Q"},
+					Mutation:    &graphqlc.NamedTypeDescriptorProto{Name: "M"},
+				},
+				Directives: []*graphqlc.DirectiveDefinitionDescriptorProto{{
+					Description: "The d",
+					Name:        "d",
+					Arguments:   []*graphqlc.InputValueDefinitionDescriptorProto{{Name: "x", Type: named("Int")}},
+					Repeatable:  true,
+					Locations: []*graphqlc.DirectiveLocationDescriptorProto{
+						{Location: &graphqlc.DirectiveLocationDescriptorProto_ExecutableLocation{
+							ExecutableLocation: graphqlc.ExecutableDirectiveLocation_QUERY,
+						}},
+						{Location: &graphqlc.DirectiveLocationDescriptorProto_TypeSystemLocation{
+							TypeSystemLocation: graphqlc.TypeSystemDirectiveLocation_SCALAR,
+						}},
+					},
+				}},
+				Scalars: []*graphqlc.ScalarTypeDefinitionDescriptorProto{{
+					Name: "URL",
+					Directives: []*graphqlc.DirectiveDescriptorProto{{
+						Name: "specifiedBy",
+						Arguments: []*graphqlc.ArgumentDescriptorProto{{
+							Name: "url",
+							Value: &graphqlc.ValueDescriptorProto{
+								Raw:   `"https://example.com"`,
+								Value: &graphqlc.ValueDescriptorProto_StringValue{StringValue: "https://example.com"},
+							},
+						}},
+					}},
+					SpecifiedByUrl: "https://example.com",
+				}},
+			},
+		},
+		{
+			name: "extensions",
+			body: `extend schema { subscription: S } extend union U = A | B extend enum E { "The X" X }`,
+			want: &graphqlc.FileDescriptorGraphql{
+				TypeExtensions: []*graphqlc.TypeSystemExtensionDescriptorProto{
+					{Extension: &graphqlc.TypeSystemExtensionDescriptorProto_SchemaExtension{
+						SchemaExtension: &graphqlc.SchemaExtensionDescriptorProto{
+							Subscription: &graphqlc.NamedTypeDescriptorProto{Name: "S"},
+						},
+					}},
+					{Extension: &graphqlc.TypeSystemExtensionDescriptorProto_TypeExtension{
+						TypeExtension: &graphqlc.TypeExtensionDescriptorProto{
+							TypeExtension: &graphqlc.TypeExtensionDescriptorProto_UnionTypeExtension{
+								UnionTypeExtension: &graphqlc.UnionTypeExtensionDefinitionDescriptorProto{
+									Name:        "U",
+									MemberTypes: []*graphqlc.NamedTypeDescriptorProto{{Name: "A"}, {Name: "B"}},
+								},
+							},
+						},
+					}},
+					{Extension: &graphqlc.TypeSystemExtensionDescriptorProto_TypeExtension{
+						TypeExtension: &graphqlc.TypeExtensionDescriptorProto{
+							TypeExtension: &graphqlc.TypeExtensionDescriptorProto_EnumTypeExtions{
+								EnumTypeExtions: &graphqlc.EnumTypeExtensionDefinitionDescriptorProto{
+									Name:   "E",
+									Values: []*graphqlc.EnumValueDefinitionDescription{{Description: "The X", Value: "X"}},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "syntax error",
+			body: "scalar A type B { b: } scalar C query { a }",
+			want: &graphqlc.FileDescriptorGraphql{
+				Scalars: []*graphqlc.ScalarTypeDefinitionDescriptorProto{{Name: "A"}, {Name: "C"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, _ := parse(tt.body)
+			if !proto.Equal(file.Descriptor, tt.want) {
+				t.Errorf("got descriptor\n%v\nwant\n%v", proto.MarshalTextString(file.Descriptor), proto.MarshalTextString(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseByteOrderMark(t *testing.T) {
+	src := source.NewSource(&source.Source{Body: []byte("\uFEFFscalar A")})
+	file, errs := Parse(src)
+	if len(errs) > 0 {
+		t.Fatalf("got errors %v", errs)
+	}
+	if string(src.Body) != "scalar A" {
+		t.Errorf("got body %q, want %q", src.Body, "scalar A")
+	}
+	if loc := file.Document.Definitions[0].GetLoc(); loc.Start != 0 || loc.End != 8 {
+		t.Errorf("got location %d-%d, want 0-8", loc.Start, loc.End)
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	file, errs := parse("type A { a: Int } # c\n\"\"\"\n  b\n\"\"\" scalar B")
+	if len(errs) > 0 {
+		t.Fatalf("got errors %v", errs)
+	}
+	wantTokens := []Token{
+		{Kind: Name, Start: 0, End: 4, Value: "type"},
+		{Kind: Name, Start: 5, End: 6, Value: "A"},
+		{Kind: BraceL, Start: 7, End: 8},
+		{Kind: Name, Start: 9, End: 10, Value: "a"},
+		{Kind: Colon, Start: 10, End: 11},
+		{Kind: Name, Start: 12, End: 15, Value: "Int"},
+		{Kind: BraceR, Start: 16, End: 17},
+		{Kind: BlockString, Start: 22, End: 33, Value: "b"},
+		{Kind: Name, Start: 34, End: 40, Value: "scalar"},
+		{Kind: Name, Start: 41, End: 42, Value: "B"},
+	}
+	if !reflect.DeepEqual(file.Tokens, wantTokens) {
+		t.Errorf("got tokens %v, want %v", file.Tokens, wantTokens)
+	}
+	wantComments := []Comment{{Start: 18, End: 21, Text: " c"}}
+	if !reflect.DeepEqual(file.Comments, wantComments) {
+		t.Errorf("got comments %v, want %v", file.Comments, wantComments)
+	}
+}
+
+func TestParseDirectivePositions(t *testing.T) {
+	type span struct{ Start, End int }
+	spans := func(directives []*ast.Directive) []span {
+		var spans []span
+		for _, directive := range directives {
+			spans = append(spans, span{directive.Loc.Start, directive.Loc.End})
+		}
+		return spans
+	}
+
+	file, errs := parse("query Q($a: Int @d @d(x: 1), $b: Int) { f @d @d }")
+	if len(errs) > 0 {
+		t.Fatalf("got errors %v", errs)
+	}
+	op := file.Document.Definitions[0].(*ast.OperationDefinition)
+
+	a, b := op.VariableDefinitions[0], op.VariableDefinitions[1]
+	if got, want := spans(file.VariableDirectives[a]), []span{{16, 18}, {19, 27}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got $a directives at %v, want %v", got, want)
+	}
+	if got := a.Loc; got.Start != 8 || got.End != 27 {
+		t.Errorf("got $a at %d-%d, want 8-27", got.Start, got.End)
+	}
+	if directives, ok := file.VariableDirectives[b]; ok {
+		t.Errorf("got $b directives %v, want none", directives)
+	}
+
+	field := op.SelectionSet.Selections[0].(*ast.Field)
+	if got, want := spans(field.Directives), []span{{42, 44}, {45, 47}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got field directives at %v, want %v", got, want)
+	}
+}
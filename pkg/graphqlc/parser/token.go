@@ -0,0 +1,86 @@
+package parser
+
+import "fmt"
+
+// TokenKind is the kind of a lexical token.
+type TokenKind int
+
+const (
+	EOF TokenKind = iota + 1
+	Bang
+	Dollar
+	Amp
+	ParenL
+	ParenR
+	Spread
+	Colon
+	Equals
+	At
+	BracketL
+	BracketR
+	BraceL
+	Pipe
+	BraceR
+	Name
+	Int
+	Float
+	String
+	BlockString
+)
+
+var tokenKindNames = map[TokenKind]string{
+	EOF:         "<EOF>",
+	Bang:        "!",
+	Dollar:      "$",
+	Amp:         "&",
+	ParenL:      "(",
+	ParenR:      ")",
+	Spread:      "...",
+	Colon:       ":",
+	Equals:      "=",
+	At:          "@",
+	BracketL:    "[",
+	BracketR:    "]",
+	BraceL:      "{",
+	Pipe:        "|",
+	BraceR:      "}",
+	Name:        "Name",
+	Int:         "Int",
+	Float:       "Float",
+	String:      "String",
+	BlockString: "BlockString",
+}
+
+func (k TokenKind) String() string {
+	if name, ok := tokenKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("TokenKind(%d)", int(k))
+}
+
+// Token is a lexical token. Start and End are byte offsets in the source.
+// Value is the name, the text of a number or the value of a string.
+type Token struct {
+	Kind       TokenKind
+	Start, End int
+	Value      string
+}
+
+// String describes the token in error messages.
+func (t Token) String() string {
+	switch t.Kind {
+	case Name, Int, Float:
+		return fmt.Sprintf("%s %q", t.Kind, t.Value)
+	case String, BlockString:
+		return t.Kind.String()
+	case EOF:
+		return t.Kind.String()
+	}
+	return fmt.Sprintf("%q", t.Kind.String())
+}
+
+// Comment is a # comment. Text excludes the # and the line terminator.
+type Comment struct {
+	Start, End int
+	Text       string
+}
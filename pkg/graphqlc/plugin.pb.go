@@ -110,8 +110,22 @@ type CodeGeneratorRequest struct {
 	// Each files descriptor will be included in graphql_file, defined below.
 	FileToGenerate []string `protobuf:"bytes,1,rep,name=file_to_generate,json=fileToGenerate,proto3" json:"file_to_generate,omitempty"`
 	Parameter      string   `protobuf:"bytes,2,opt,name=parameter,proto3" json:"parameter,omitempty"`
-	// FileDescriptorGraphql for all files in files_to_generate.
+	// FileDescriptorGraphql for all files in files_to_generate and everything
+	// they import. The files will appear in topological order, so each file
+	// appears before any file that imports it. Operations and fragments are
+	// in the operations and fragments of the file defining them.
+	// The scalars and directives of the GraphQL specification are defined in
+	// the file graphqlc/builtin.graphql, always the first file, and the
+	// @connection directive of graphqlc in graphqlc/directives.graphql,
+	// always the second file.
 	GraphqlFile []*FileDescriptorGraphql `protobuf:"bytes,15,rep,name=graphql_file,json=graphqlFile,proto3" json:"graphql_file,omitempty"`
+	// The schema of all files in graphql_file. It is the schema definition,
+	// wherever it is defined, or the root operation types Query, Mutation
+	// and Subscription when there is none.
+	Schema *SchemaDescriptorProto `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The schema variant given by --variant, empty if none is. Each variant
+	// is given to plugins as a request of its own.
+	Variant string `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
 	// The version number of graphql compiler
 	CompilerVersion *Version `protobuf:"bytes,3,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
 }
@@ -169,6 +183,20 @@ func (x *CodeGeneratorRequest) GetGraphqlFile() []*FileDescriptorGraphql {
 	return nil
 }
 
+func (x *CodeGeneratorRequest) GetSchema() *SchemaDescriptorProto {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *CodeGeneratorRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
 func (x *CodeGeneratorRequest) GetCompilerVersion() *Version {
 	if x != nil {
 		return x.CompilerVersion
@@ -241,6 +269,82 @@ func (x *CodeGeneratorResponse) GetFile() []*CodeGeneratorResponse_File {
 	return nil
 }
 
+// A transformer plugin is given the same CodeGeneratorRequest as a code
+// generator and writes an encoded TransformerResponse to stdout. Transformers
+// run in the order in which they appear on the command line, each given the
+// files returned by the one before, before any code generator is run.
+type TransformerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Error message. If non-empty, the transformation failed, as for
+	// CodeGeneratorResponse.error.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Every file of the request, transformed, in topological order. Files
+	// may be added or removed but every file in file_to_generate must be
+	// returned. The transformed files are validated again before they are
+	// given to the next plugin, errors are reported at the spans given by
+	// their SourceCodeInfo. A descriptor added should be given the span of
+	// the definition it is derived from.
+	GraphqlFile []*FileDescriptorGraphql `protobuf:"bytes,15,rep,name=graphql_file,json=graphqlFile,proto3" json:"graphql_file,omitempty"`
+	// The schema of graphql_file. If unset the schema of the request is kept.
+	Schema *SchemaDescriptorProto `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *TransformerResponse) Reset() {
+	*x = TransformerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransformerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransformerResponse) ProtoMessage() {}
+
+func (x *TransformerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransformerResponse.ProtoReflect.Descriptor instead.
+func (*TransformerResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransformerResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TransformerResponse) GetGraphqlFile() []*FileDescriptorGraphql {
+	if x != nil {
+		return x.GraphqlFile
+	}
+	return nil
+}
+
+func (x *TransformerResponse) GetSchema() *SchemaDescriptorProto {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
 // Represents a single generated file.
 type CodeGeneratorResponse_File struct {
 	state         protoimpl.MessageState
@@ -296,7 +400,7 @@ type CodeGeneratorResponse_File struct {
 func (x *CodeGeneratorResponse_File) Reset() {
 	*x = CodeGeneratorResponse_File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plugin_proto_msgTypes[3]
+		mi := &file_plugin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +413,7 @@ func (x *CodeGeneratorResponse_File) String() string {
 func (*CodeGeneratorResponse_File) ProtoMessage() {}
 
 func (x *CodeGeneratorResponse_File) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[3]
+	mi := &file_plugin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +462,7 @@ var file_plugin_proto_rawDesc = []byte{
 	0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
 	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68,
 	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0xe9, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x64,
+	0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x22, 0xbc, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x64,
 	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x6e,
 	0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c,
@@ -368,29 +472,45 @@ var file_plugin_proto_rawDesc = []byte{
 	0x70, 0x68, 0x71, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c,
-	0x52, 0x0b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x45, 0x0a,
-	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71,
-	0x6c, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x5d, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x69, 0x74, 0x6f, 0x77, 0x69, 0x74, 0x7a,
-	0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x64, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x5d, 0x0a, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x71, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x61, 0x6d, 0x6c, 0x69, 0x74, 0x6f, 0x77, 0x69, 0x74, 0x7a, 0x2f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x71, 0x6c, 0x63, 0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x63, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -405,23 +525,28 @@ func file_plugin_proto_rawDescGZIP() []byte {
 	return file_plugin_proto_rawDescData
 }
 
-var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_plugin_proto_goTypes = []interface{}{
 	(*Version)(nil),                    // 0: graphqlc.compiler.Version
 	(*CodeGeneratorRequest)(nil),       // 1: graphqlc.compiler.CodeGeneratorRequest
 	(*CodeGeneratorResponse)(nil),      // 2: graphqlc.compiler.CodeGeneratorResponse
-	(*CodeGeneratorResponse_File)(nil), // 3: graphqlc.compiler.CodeGeneratorResponse.File
-	(*FileDescriptorGraphql)(nil),      // 4: graphqlc.FileDescriptorGraphql
+	(*TransformerResponse)(nil),        // 3: graphqlc.compiler.TransformerResponse
+	(*CodeGeneratorResponse_File)(nil), // 4: graphqlc.compiler.CodeGeneratorResponse.File
+	(*FileDescriptorGraphql)(nil),      // 5: graphqlc.FileDescriptorGraphql
+	(*SchemaDescriptorProto)(nil),      // 6: graphqlc.SchemaDescriptorProto
 }
 var file_plugin_proto_depIdxs = []int32{
-	4, // 0: graphqlc.compiler.CodeGeneratorRequest.graphql_file:type_name -> graphqlc.FileDescriptorGraphql
-	0, // 1: graphqlc.compiler.CodeGeneratorRequest.compiler_version:type_name -> graphqlc.compiler.Version
-	3, // 2: graphqlc.compiler.CodeGeneratorResponse.file:type_name -> graphqlc.compiler.CodeGeneratorResponse.File
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 0: graphqlc.compiler.CodeGeneratorRequest.graphql_file:type_name -> graphqlc.FileDescriptorGraphql
+	6, // 1: graphqlc.compiler.CodeGeneratorRequest.schema:type_name -> graphqlc.SchemaDescriptorProto
+	0, // 2: graphqlc.compiler.CodeGeneratorRequest.compiler_version:type_name -> graphqlc.compiler.Version
+	4, // 3: graphqlc.compiler.CodeGeneratorResponse.file:type_name -> graphqlc.compiler.CodeGeneratorResponse.File
+	5, // 4: graphqlc.compiler.TransformerResponse.graphql_file:type_name -> graphqlc.FileDescriptorGraphql
+	6, // 5: graphqlc.compiler.TransformerResponse.schema:type_name -> graphqlc.SchemaDescriptorProto
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_plugin_proto_init() }
@@ -468,6 +593,18 @@ func file_plugin_proto_init() {
 			}
 		}
 		file_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransformerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CodeGeneratorResponse_File); i {
 			case 0:
 				return &v.state
@@ -486,7 +623,7 @@ func file_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plugin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
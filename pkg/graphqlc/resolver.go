@@ -0,0 +1,253 @@
+package graphqlc
+
+// Resolver finds the definitions referenced by name in a request. Types,
+// root operation types, interfaces implemented and union members are
+// referenced with a NamedTypeDescriptorProto rather than copied into every
+// descriptor referencing them.
+type Resolver struct {
+	schema *SchemaDescriptorProto
+
+	files        map[string]*FileDescriptorGraphql
+	scalars      map[string]*ScalarTypeDefinitionDescriptorProto
+	objects      map[string]*ObjectTypeDefinitionDescriptorProto
+	interfaces   map[string]*InterfaceTypeDefinitionDescriptorProto
+	unions       map[string]*UnionTypeDefinitionDescriptorProto
+	enums        map[string]*EnumTypeDefinitionDescriptorProto
+	inputObjects map[string]*InputObjectTypeDefinitionDescriptorProto
+	directives   map[string]*DirectiveDefinitionDescriptorProto
+	fragments    map[string]*FragmentDescriptorProto
+
+	// Objects and interfaces implementing each interface
+	implementations map[string][]interface{}
+	// Fields added to objects and interfaces by type extensions
+	extensionFields map[string][]*FieldDefinitionDescriptorProto
+}
+
+// typeNameField is the meta-field every object, interface and union has.
+var typeNameField = &FieldDefinitionDescriptorProto{
+	Name: "__typename",
+	Type: &TypeDescriptorProto{
+		Type: &TypeDescriptorProto_NonNullType{
+			NonNullType: &NonNullTypeDescriptorProto{
+				Type: &NonNullTypeDescriptorProto_NamedType{
+					NamedType: &NamedTypeDescriptorProto{
+						Name: "String",
+						Kind: NamedTypeDescriptorProto_SCALAR,
+						File: "graphqlc/builtin.graphql",
+					},
+				},
+			},
+		},
+	},
+}
+
+// NewResolver indexes the definitions of every file of a request.
+func NewResolver(req *CodeGeneratorRequest) *Resolver {
+	r := &Resolver{
+		schema:          req.Schema,
+		files:           make(map[string]*FileDescriptorGraphql),
+		scalars:         make(map[string]*ScalarTypeDefinitionDescriptorProto),
+		objects:         make(map[string]*ObjectTypeDefinitionDescriptorProto),
+		interfaces:      make(map[string]*InterfaceTypeDefinitionDescriptorProto),
+		unions:          make(map[string]*UnionTypeDefinitionDescriptorProto),
+		enums:           make(map[string]*EnumTypeDefinitionDescriptorProto),
+		inputObjects:    make(map[string]*InputObjectTypeDefinitionDescriptorProto),
+		directives:      make(map[string]*DirectiveDefinitionDescriptorProto),
+		fragments:       make(map[string]*FragmentDescriptorProto),
+		implementations: make(map[string][]interface{}),
+		extensionFields: make(map[string][]*FieldDefinitionDescriptorProto),
+	}
+	for _, fd := range req.GraphqlFile {
+		r.files[fd.Name] = fd
+		for _, desc := range fd.Scalars {
+			r.scalars[desc.Name] = desc
+		}
+		for _, desc := range fd.Objects {
+			r.objects[desc.Name] = desc
+			for _, ref := range desc.Implements {
+				r.implementations[ref.Name] = append(r.implementations[ref.Name], desc)
+			}
+		}
+		for _, desc := range fd.Interfaces {
+			r.interfaces[desc.Name] = desc
+			for _, ref := range desc.Implements {
+				r.implementations[ref.Name] = append(r.implementations[ref.Name], desc)
+			}
+		}
+		for _, desc := range fd.Unions {
+			r.unions[desc.Name] = desc
+		}
+		for _, desc := range fd.Enums {
+			r.enums[desc.Name] = desc
+		}
+		for _, desc := range fd.InputObjects {
+			r.inputObjects[desc.Name] = desc
+		}
+		for _, desc := range fd.Directives {
+			r.directives[desc.Name] = desc
+		}
+		for _, desc := range fd.Fragments {
+			r.fragments[desc.Name] = desc
+		}
+		for _, ext := range fd.TypeExtensions {
+			switch ext := ext.GetTypeExtension().GetTypeExtension().(type) {
+			case *TypeExtensionDescriptorProto_ObjectTypeExtension:
+				desc := ext.ObjectTypeExtension
+				r.extensionFields[desc.Name] = append(r.extensionFields[desc.Name], desc.Fields...)
+			case *TypeExtensionDescriptorProto_InterfaceTypeExtension:
+				desc := ext.InterfaceTypeExtension
+				r.extensionFields[desc.Name] = append(r.extensionFields[desc.Name], desc.Fields...)
+			}
+		}
+	}
+	return r
+}
+
+// File returns the file named name, nil if there is none.
+func (r *Resolver) File(name string) *FileDescriptorGraphql {
+	return r.files[name]
+}
+
+// Type returns the definition of the type named name, nil if there is none.
+func (r *Resolver) Type(name string) interface{} {
+	if desc, ok := r.scalars[name]; ok {
+		return desc
+	}
+	if desc, ok := r.objects[name]; ok {
+		return desc
+	}
+	if desc, ok := r.interfaces[name]; ok {
+		return desc
+	}
+	if desc, ok := r.unions[name]; ok {
+		return desc
+	}
+	if desc, ok := r.enums[name]; ok {
+		return desc
+	}
+	if desc, ok := r.inputObjects[name]; ok {
+		return desc
+	}
+	return nil
+}
+
+// NamedType returns the definition of the type referenced by ref, nil if
+// there is none.
+func (r *Resolver) NamedType(ref *NamedTypeDescriptorProto) interface{} {
+	if ref == nil {
+		return nil
+	}
+	return r.Type(ref.Name)
+}
+
+func (r *Resolver) Scalar(name string) *ScalarTypeDefinitionDescriptorProto {
+	return r.scalars[name]
+}
+
+func (r *Resolver) Object(name string) *ObjectTypeDefinitionDescriptorProto {
+	return r.objects[name]
+}
+
+func (r *Resolver) Interface(name string) *InterfaceTypeDefinitionDescriptorProto {
+	return r.interfaces[name]
+}
+
+func (r *Resolver) Union(name string) *UnionTypeDefinitionDescriptorProto {
+	return r.unions[name]
+}
+
+func (r *Resolver) Enum(name string) *EnumTypeDefinitionDescriptorProto {
+	return r.enums[name]
+}
+
+func (r *Resolver) InputObject(name string) *InputObjectTypeDefinitionDescriptorProto {
+	return r.inputObjects[name]
+}
+
+func (r *Resolver) Directive(name string) *DirectiveDefinitionDescriptorProto {
+	return r.directives[name]
+}
+
+func (r *Resolver) Fragment(name string) *FragmentDescriptorProto {
+	return r.fragments[name]
+}
+
+// Query returns the query root operation type, nil if there is none.
+func (r *Resolver) Query() *ObjectTypeDefinitionDescriptorProto {
+	return r.root(r.schema.GetQuery())
+}
+
+// Mutation returns the mutation root operation type, nil if there is none.
+func (r *Resolver) Mutation() *ObjectTypeDefinitionDescriptorProto {
+	return r.root(r.schema.GetMutation())
+}
+
+// Subscription returns the subscription root operation type, nil if there
+// is none.
+func (r *Resolver) Subscription() *ObjectTypeDefinitionDescriptorProto {
+	return r.root(r.schema.GetSubscription())
+}
+
+func (r *Resolver) root(ref *NamedTypeDescriptorProto) *ObjectTypeDefinitionDescriptorProto {
+	if ref == nil {
+		return nil
+	}
+	return r.objects[ref.Name]
+}
+
+// Interfaces returns the interfaces referenced by refs, such as those an
+// object or interface implements. Interfaces not defined are skipped.
+func (r *Resolver) Interfaces(refs []*NamedTypeDescriptorProto) []*InterfaceTypeDefinitionDescriptorProto {
+	var descs []*InterfaceTypeDefinitionDescriptorProto
+	for _, ref := range refs {
+		if desc, ok := r.interfaces[ref.Name]; ok {
+			descs = append(descs, desc)
+		}
+	}
+	return descs
+}
+
+// Objects returns the objects referenced by refs, such as the members of a
+// union. Objects not defined are skipped.
+func (r *Resolver) Objects(refs []*NamedTypeDescriptorProto) []*ObjectTypeDefinitionDescriptorProto {
+	var descs []*ObjectTypeDefinitionDescriptorProto
+	for _, ref := range refs {
+		if desc, ok := r.objects[ref.Name]; ok {
+			descs = append(descs, desc)
+		}
+	}
+	return descs
+}
+
+// Field returns the field of the schema selected by sel, nil if its parent
+// type is unset. Fields added by type extensions are found whether or not
+// extensions were merged.
+func (r *Resolver) Field(sel *FieldSelectionDescriptorProto) *FieldDefinitionDescriptorProto {
+	if sel.GetParentType() == nil {
+		return nil
+	}
+	if sel.Name == typeNameField.Name {
+		return typeNameField
+	}
+	var fields []*FieldDefinitionDescriptorProto
+	switch desc := r.NamedType(sel.ParentType).(type) {
+	case *ObjectTypeDefinitionDescriptorProto:
+		fields = desc.Fields
+	case *InterfaceTypeDefinitionDescriptorProto:
+		fields = desc.Fields
+	}
+	fields = append(fields[:len(fields):len(fields)], r.extensionFields[sel.ParentType.Name]...)
+	for _, field := range fields {
+		if field.Name == sel.Name {
+			return field
+		}
+	}
+	return nil
+}
+
+// Implementations returns the objects and interfaces implementing the
+// interface named name, each either an *ObjectTypeDefinitionDescriptorProto
+// or an *InterfaceTypeDefinitionDescriptorProto.
+func (r *Resolver) Implementations(name string) []interface{} {
+	return r.implementations[name]
+}